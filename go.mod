@@ -9,16 +9,21 @@ require (
 	github.com/google/go-cmp v0.7.0
 	github.com/google/go-github/v90 v90.0.0
 	github.com/google/go-jsonnet v0.22.0
+	github.com/klauspost/compress v1.20.1
 	github.com/lintnet/go-jsonnet-native-functions v0.4.2
 	github.com/otiai10/copy v1.14.1
 	github.com/santhosh-tekuri/jsonschema/v6 v6.0.3
 	github.com/spf13/afero v1.15.0
 	github.com/suzuki-shunsuke/go-convmap v0.2.1
+	github.com/suzuki-shunsuke/go-error-with-exit-code v1.0.0
 	github.com/suzuki-shunsuke/slog-error v0.2.2
 	github.com/suzuki-shunsuke/slog-util v0.3.2
 	github.com/suzuki-shunsuke/urfave-cli-v3-util v0.2.3
+	github.com/tailscale/hujson v0.0.0-20250605163823-992244df8c5a
+	github.com/titanous/json5 v1.0.0
 	github.com/tmccombs/hcl2json v0.6.9
 	github.com/urfave/cli/v3 v3.11.0
+	github.com/yuin/goldmark v1.8.6
 	golang.org/x/oauth2 v0.36.0
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -34,7 +39,6 @@ require (
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mitchellh/go-wordwrap v1.0.1 // indirect
 	github.com/otiai10/mint v1.6.3 // indirect
-	github.com/suzuki-shunsuke/go-error-with-exit-code v1.0.0 // indirect
 	github.com/zclconf/go-cty v1.18.0 // indirect
 	golang.org/x/crypto v0.45.0 // indirect
 	golang.org/x/mod v0.29.0 // indirect
@@ -1,3 +1,102 @@
+local file_types = [
+  'csv',
+  'dockerfile',
+  'dotenv',
+  'hcl2',
+  'ini',
+  'json',
+  'json5',
+  'jsonc',
+  'jsonnet',
+  'markdown',
+  'plain_text',
+  'properties',
+  'toml',
+  'tsv',
+  'xml',
+  'yaml',
+];
+
+local file_type = {
+  anyOf: [
+    {
+      type: 'string',
+      description: 'file type',
+      enum: file_types,
+    },
+    {
+      type: 'object',
+      additionalProperties: false,
+      required: [
+        'type',
+      ],
+      properties: {
+        type: {
+          type: 'string',
+          description: 'file type',
+          enum: file_types,
+        },
+        csv: {
+          type: 'object',
+          description: 'Options of csv and tsv',
+          additionalProperties: false,
+          properties: {
+            header: {
+              type: 'boolean',
+              description: 'If true, the first row is treated as the header and each row is converted to an object keyed by the header. Rows whose number of fields is different from the header are reported in metadata.errors',
+            },
+            delimiter: {
+              type: 'string',
+              description: 'A field delimiter. The default is a comma for csv and a tab for tsv',
+            },
+            comment: {
+              type: 'string',
+              description: 'A comment character. Lines beginning with the comment character are ignored',
+            },
+            lazy_quotes: {
+              type: 'boolean',
+              description: 'If true, a quote may appear in an unquoted field and a non-doubled quote may appear in a quoted field',
+            },
+            limit: {
+              type: 'integer',
+              description: 'The maximum number of rows excluding the header. Rows after the limit are ignored. If this is 0, all rows are read',
+              minimum: 0,
+            },
+          },
+        },
+        yaml: {
+          type: 'object',
+          description: 'Options of yaml',
+          additionalProperties: false,
+          properties: {
+            metadata: {
+              type: 'boolean',
+              description: 'If true, positions, duplicate keys, anchors, and aliases are set to the metadata of data files. Duplicate keys are not treated as errors',
+            },
+          },
+        },
+        jsonnet: {
+          type: 'object',
+          description: 'Options of jsonnet',
+          additionalProperties: false,
+          properties: {
+            tla: {
+              type: 'object',
+              description: 'Top level arguments. Values are passed as code',
+              additionalProperties: true,
+            },
+            ext_vars: {
+              type: 'object',
+              description: 'External variables. Values are passed as code',
+              additionalProperties: true,
+            },
+          },
+        },
+      },
+    },
+  ],
+};
+
 local lint_data = {
   type: 'object',
   description: 'data file',
@@ -17,20 +116,17 @@ local lint_data = {
     file_type: {
       type: 'string',
       description: 'data file type',
-      enum: [
-        'csv',
-        'hcl2',
-        'json',
-        'plain_text',
-        'toml',
-        'tsv',
-        'yaml',
-      ],
+      enum: file_types,
+    },
+    metadata: {
+      description: 'metadata of the data file. This is set only if it is enabled by file_types. e.g. positions of YAML and errors of CSV rows',
     },
   },
 };
 
 {
+  file_types: file_types,
+  file_type: file_type,
   lint_tla: {
     type: 'object',
     additionalProperties: false,
@@ -140,6 +236,49 @@ local lint_data = {
           type: 'boolean',
           description: 'Whether the result is excluded',
         },
+        exclusion_reason: {
+          type: 'string',
+          description: 'The reason why the result is excluded. This is outputted with -show-excluded',
+        },
+        data_file: {
+          type: 'string',
+          description: 'The primary data file of the error. This is used only in combine lint files',
+        },
+        related: {
+          type: 'array',
+          description: 'Locations related to the error',
+          items: {
+            type: 'object',
+            additionalProperties: false,
+            properties: {
+              data_file: {
+                type: 'string',
+                description: 'data file path',
+              },
+              location: {
+                oneOf: [
+                  {
+                    type: 'object',
+                    description: 'Location in the data file',
+                    additionalProperties: true,
+                  },
+                  {
+                    type: 'string',
+                    description: 'Location in the data file',
+                  },
+                ],
+              },
+              message: {
+                type: 'string',
+                description: 'message',
+              },
+            },
+          },
+        },
+        fingerprint: {
+          type: 'string',
+          description: 'A stable identity of the result. By default, lintnet computes the fingerprint from the target id, the lint file, the rule name, the data file, and the location or the message',
+        },
         custom: {
           type: 'object',
           description: 'Custom fields that users can set freely',
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lintnet/lintnet/pkg/controller/lint"
+	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
+	"github.com/suzuki-shunsuke/slog-util/slogutil"
+	"github.com/urfave/cli/v3"
+)
+
+type diffCommand struct {
+	version string
+}
+
+type DiffArgs struct {
+	*GlobalFlags
+
+	Output      string
+	Format      string
+	ErrorLevel  string
+	OldFilePath string
+	NewFilePath string
+}
+
+func (dc *diffCommand) command(logger *slogutil.Logger, gFlags *GlobalFlags) *cli.Command {
+	args := &DiffArgs{
+		GlobalFlags: gFlags,
+	}
+	return &cli.Command{
+		Name:      "diff",
+		Usage:     "Compare two results of lintnet lint",
+		UsageText: "lintnet diff [command options] <old result file> <new result file>",
+		Description: `Compare two results of lintnet lint and output new, fixed, and unchanged errors.
+Result files must be outputted by lintnet lint in JSON format.
+Errors are matched by fingerprints.
+
+$ lintnet lint -output-success > new.json
+$ lintnet diff old.json new.json
+
+New errors are outputted as errors, and the field "diff" has the numbers of new, fixed, and unchanged errors and fixed and unchanged errors.
+You can output the result in a built-in format or a format configured in a configuration file.
+
+$ lintnet diff -format rdjsonl old.json new.json
+$ lintnet diff -output markdown old.json new.json
+
+If new errors at or above the error level are found, the exit code is 1.
+`,
+		Action: func(ctx context.Context, _ *cli.Command) error {
+			return dc.action(ctx, logger, args)
+		},
+		OnUsageError: func(_ context.Context, _ *cli.Command, err error, _ bool) error {
+			return ecerror.Wrap(err, lint.ExitCodeConfig)
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "You can customize the output format. You can specify an output id",
+				Destination: &args.Output,
+			},
+			&cli.StringFlag{
+				Name:        "format",
+				Aliases:     []string{"f"},
+				Usage:       "Output the result in a built-in format. json, checkstyle, rdjson, rdjsonl, gitlab-codequality, html-report, ndjson",
+				Sources:     cli.EnvVars("LINTNET_FORMAT"),
+				Destination: &args.Format,
+			},
+			&cli.StringFlag{
+				Name:        "error-level",
+				Aliases:     []string{"e"},
+				Usage:       "Set the error level of new errors",
+				Sources:     cli.EnvVars("LINTNET_ERROR_LEVEL"),
+				Destination: &args.ErrorLevel,
+			},
+		},
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:        "old",
+				Destination: &args.OldFilePath,
+			},
+			&cli.StringArg{
+				Name:        "new",
+				Destination: &args.NewFilePath,
+			},
+		},
+	}
+}
+
+func (dc *diffCommand) action(ctx context.Context, logger *slogutil.Logger, args *DiffArgs) error {
+	if err := logger.SetLevel(args.LogLevel); err != nil {
+		return ecerror.Wrap(fmt.Errorf("set log level: %w", err), lint.ExitCodeConfig)
+	}
+	if args.OldFilePath == "" || args.NewFilePath == "" {
+		return ecerror.Wrap(errors.New("an old result file and a new result file are required"), lint.ExitCodeConfig)
+	}
+	fs := afero.NewOsFs()
+	rootDir := getRootDir(logger)
+	var ctrl *lint.Controller
+	if args.Output == "" {
+		// Built-in formats need neither modules nor Jsonnet, so a GitHub client and an importer aren't created.
+		ctrl = lint.NewController(newLintParamController(dc.version), fs, os.Stdout, nil, nil)
+	} else {
+		c, err := newLintController(ctx, logger, fs, rootDir, dc.version)
+		if err != nil {
+			return err
+		}
+		ctrl = c
+	}
+	pwd, err := os.Getwd()
+	if err != nil {
+		return ecerror.Wrap(fmt.Errorf("get the current directory: %w", err), lint.ExitCodeConfig)
+	}
+	return ctrl.Diff(ctx, logger.Logger, &lint.ParamDiff{ //nolint:wrapcheck
+		OldFilePath:    args.OldFilePath,
+		NewFilePath:    args.NewFilePath,
+		ErrorLevel:     args.ErrorLevel,
+		ConfigFilePath: args.Config,
+		Output:         args.Output,
+		Format:         args.Format,
+		RootDir:        rootDir,
+		PWD:            pwd,
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/lintnet/lintnet/pkg/jsonnet"
 	"github.com/lintnet/lintnet/pkg/module"
 	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
 	"github.com/suzuki-shunsuke/slog-error/slogerr"
 	"github.com/suzuki-shunsuke/slog-util/slogutil"
 	"github.com/urfave/cli/v3"
@@ -26,11 +27,16 @@ type LintArgs struct {
 	*GlobalFlags
 
 	Output          string
+	Format          string
 	Target          string
 	ErrorLevel      string
 	ShownErrorLevel string
 	OutputSuccess   bool
-	FilePaths       []string
+	// EvaluationErrorAsViolation treats errors of lint file evaluation as lint violations.
+	EvaluationErrorAsViolation bool
+	ShowExcluded               bool
+	Owners                     []string
+	FilePaths                  []string
 }
 
 func (lc *lintCommand) command(logger *slogutil.Logger, gFlags *GlobalFlags) *cli.Command { //nolint:funlen
@@ -58,10 +64,45 @@ By default, lintnet outputs nothing when the lint succeeds.
 You can output JSON even if the lint succeeds. This is useful if you pass the output to other program such as jq.
 
 $ lintnet lint -output-success
+
+You can output the result in a built-in format.
+
+$ lintnet lint -format checkstyle
+
+The following formats are available.
+
+- json (default)
+- checkstyle: Checkstyle XML
+- rdjson: reviewdog Diagnostic Format (rdjson)
+- rdjsonl: reviewdog Diagnostic Format (rdjsonl)
+- gitlab-codequality: GitLab Code Quality report
+- html-report: Self-contained HTML report
+- ndjson: Newline delimited JSON. Errors are outputted as soon as lint files are evaluated
+
+$ lintnet lint -format html-report > report.html
+
+You can filter errors by owners of data files in CODEOWNERS.
+CODEOWNERS is searched in the root directory of the Git repository including the configuration file and its .github and docs directories.
+
+$ lintnet lint -owner @org/team-x
+
+Exit codes:
+
+0: No violation
+1: Lint violations at or above the error level, including data files failing to be parsed
+2: Configuration or usage errors, or failures of outputs such as templates, transforms, and commands
+3: Failed to install modules
+4: Failed to evaluate lint files, or lint files returned invalid results such as invalid error levels
+
+By default, errors of lint file evaluation are treated as infrastructure failures and the exit code is 4.
+If -evaluation-error-as-violation is set, they are treated as lint violations and the exit code is 1.
 `,
 		Action: func(ctx context.Context, _ *cli.Command) error {
 			return lc.action(ctx, logger, args)
 		},
+		OnUsageError: func(_ context.Context, _ *cli.Command, err error, _ bool) error {
+			return ecerror.Wrap(err, lint.ExitCodeConfig)
+		},
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:        "output",
@@ -69,6 +110,13 @@ $ lintnet lint -output-success
 				Usage:       "You can customize the output format. You can specify an output id",
 				Destination: &args.Output,
 			},
+			&cli.StringFlag{
+				Name:        "format",
+				Aliases:     []string{"f"},
+				Usage:       "Output the result in a built-in format. json, checkstyle, rdjson, rdjsonl, gitlab-codequality, html-report, ndjson",
+				Sources:     cli.EnvVars("LINTNET_FORMAT"),
+				Destination: &args.Format,
+			},
 			&cli.StringFlag{
 				Name:        "target",
 				Aliases:     []string{"t"},
@@ -94,6 +142,24 @@ $ lintnet lint -output-success
 				Sources:     cli.EnvVars("LINTNET_OUTPUT_SUCCESS"),
 				Destination: &args.OutputSuccess,
 			},
+			&cli.BoolFlag{
+				Name:        "evaluation-error-as-violation",
+				Usage:       "Treat errors of lint file evaluation as lint violations. By default, they are treated as infrastructure failures and the exit code is 4",
+				Sources:     cli.EnvVars("LINTNET_EVALUATION_ERROR_AS_VIOLATION"),
+				Destination: &args.EvaluationErrorAsViolation,
+			},
+			&cli.BoolFlag{
+				Name:        "show-excluded",
+				Usage:       "Output errors excluded by lint files in the field excluded. Excluded errors never fail the lint",
+				Sources:     cli.EnvVars("LINTNET_SHOW_EXCLUDED"),
+				Destination: &args.ShowExcluded,
+			},
+			&cli.StringSliceFlag{
+				Name:        "owner",
+				Usage:       "Filter errors by owners in CODEOWNERS. This option can be specified multiple times",
+				Sources:     cli.EnvVars("LINTNET_OWNER"),
+				Destination: &args.Owners,
+			},
 		},
 		Arguments: []cli.Argument{
 			&cli.StringArgs{
@@ -108,19 +174,52 @@ $ lintnet lint -output-success
 func (lc *lintCommand) action(ctx context.Context, logger *slogutil.Logger, args *LintArgs) error {
 	fs := afero.NewOsFs()
 	if err := logger.SetLevel(args.LogLevel); err != nil {
-		return fmt.Errorf("set log level: %w", err)
+		return ecerror.Wrap(fmt.Errorf("set log level: %w", err), lint.ExitCodeConfig)
+	}
+	rootDir := getRootDir(logger)
+	ctrl, err := newLintController(ctx, logger, fs, rootDir, lc.version)
+	if err != nil {
+		return err
+	}
+	pwd, err := os.Getwd()
+	if err != nil {
+		return ecerror.Wrap(fmt.Errorf("get the current directory: %w", err), lint.ExitCodeConfig)
+	}
+	return ctrl.Lint(ctx, logger.Logger, &lint.ParamLint{ //nolint:wrapcheck
+		FilePaths:                  args.FilePaths,
+		ErrorLevel:                 args.ErrorLevel,
+		ShownErrorLevel:            args.ShownErrorLevel,
+		ConfigFilePath:             args.Config,
+		TargetID:                   args.Target,
+		OutputSuccess:              args.OutputSuccess,
+		EvaluationErrorAsViolation: args.EvaluationErrorAsViolation,
+		ShowExcluded:               args.ShowExcluded,
+		Owners:                     args.Owners,
+		Output:                     args.Output,
+		Format:                     args.Format,
+		RootDir:                    rootDir,
+		DataRootDir:                pwd,
+		PWD:                        pwd,
+	})
+}
+
+// getRootDir returns the root directory of lintnet where modules are installed.
+func getRootDir(logger *slogutil.Logger) string {
+	if rootDir := os.Getenv("LINTNET_ROOT_DIR"); rootDir != "" {
+		return rootDir
 	}
-	rootDir := os.Getenv("LINTNET_ROOT_DIR")
-	if rootDir == "" {
-		dir, err := config.GetRootDir()
-		if err != nil {
-			slogerr.WithError(logger.Logger, err).Warn("get the root directory")
-		}
-		rootDir = dir
+	dir, err := config.GetRootDir()
+	if err != nil {
+		slogerr.WithError(logger.Logger, err).Warn("get the root directory")
 	}
+	return dir
+}
+
+// newLintController creates a lint controller with a GitHub client, a module installer, and a Jsonnet importer.
+func newLintController(ctx context.Context, logger *slogutil.Logger, fs afero.Fs, rootDir, version string) (*lint.Controller, error) {
 	ghClient, err := github.New(ctx)
 	if err != nil {
-		return fmt.Errorf("create a GitHub client: %w", err)
+		return nil, ecerror.Wrap(fmt.Errorf("create a GitHub client: %w", err), lint.ExitCodeConfig)
 	}
 	modInstaller := module.NewInstaller(fs, ghClient, http.DefaultClient)
 	importer := jsonnet.NewImporter(ctx, logger.Logger, &module.ParamInstall{
@@ -128,25 +227,12 @@ func (lc *lintCommand) action(ctx context.Context, logger *slogutil.Logger, args
 	}, &jsonnet.FileImporter{
 		JPaths: []string{rootDir},
 	}, modInstaller)
-	param := &lint.ParamController{
-		Version: lc.version,
+	return lint.NewController(newLintParamController(version), fs, os.Stdout, modInstaller, importer), nil
+}
+
+func newLintParamController(version string) *lint.ParamController {
+	return &lint.ParamController{
+		Version: version,
 		Env:     fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
 	}
-	ctrl := lint.NewController(param, fs, os.Stdout, modInstaller, importer)
-	pwd, err := os.Getwd()
-	if err != nil {
-		return fmt.Errorf("get the current directory: %w", err)
-	}
-	return ctrl.Lint(ctx, logger.Logger, &lint.ParamLint{ //nolint:wrapcheck
-		FilePaths:       args.FilePaths,
-		ErrorLevel:      args.ErrorLevel,
-		ShownErrorLevel: args.ShownErrorLevel,
-		ConfigFilePath:  args.Config,
-		TargetID:        args.Target,
-		OutputSuccess:   args.OutputSuccess,
-		Output:          args.Output,
-		RootDir:         rootDir,
-		DataRootDir:     pwd,
-		PWD:             pwd,
-	})
 }
@@ -39,6 +39,9 @@ func Run(ctx context.Context, logger *slogutil.Logger, env *urfave.Env) error {
 			(&lintCommand{
 				version: env.Version,
 			}).command(logger, gFlags),
+			(&diffCommand{
+				version: env.Version,
+			}).command(logger, gFlags),
 			(&infoCommand{
 				version: env.Version,
 			}).command(logger, gFlags),
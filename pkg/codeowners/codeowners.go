@@ -0,0 +1,214 @@
+// Package codeowners parses CODEOWNERS files and finds owners of files.
+// https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners
+package codeowners
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
+)
+
+// CodeOwners is a parsed CODEOWNERS file.
+type CodeOwners struct {
+	rules []*rule
+}
+
+type rule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+// Paths are locations of CODEOWNERS files relative to the repository root.
+// The order is the precedence of GitHub.
+var Paths = []string{ //nolint:gochecknoglobals
+	filepath.Join(".github", "CODEOWNERS"),
+	"CODEOWNERS",
+	filepath.Join("docs", "CODEOWNERS"),
+}
+
+var errNotFound = errors.New("CODEOWNERS isn't found")
+
+// FindRoot returns the root directory of the Git repository including dir.
+// GitHub resolves CODEOWNERS and its patterns from the repository root.
+// If dir isn't in a Git repository, dir is returned.
+func FindRoot(fs afero.Fs, dir string) (string, error) {
+	for d := dir; ; {
+		// .git is a directory in a normal repository and a file in a worktree or a submodule.
+		exist, err := afero.Exists(fs, filepath.Join(d, ".git"))
+		if err != nil {
+			return "", fmt.Errorf("check if .git exists: %w", slogerr.With(err, "dir", d))
+		}
+		if exist {
+			return d, nil
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return dir, nil
+		}
+		d = parent
+	}
+}
+
+// Read finds a CODEOWNERS file in the root directory and parses it.
+func Read(fs afero.Fs, rootDir string) (*CodeOwners, error) {
+	for _, p := range Paths {
+		p = filepath.Join(rootDir, p)
+		exist, err := afero.Exists(fs, p)
+		if err != nil {
+			return nil, fmt.Errorf("check if CODEOWNERS exists: %w", slogerr.With(err, "codeowners", p))
+		}
+		if !exist {
+			continue
+		}
+		f, err := fs.Open(p)
+		if err != nil {
+			return nil, fmt.Errorf("open CODEOWNERS: %w", slogerr.With(err, "codeowners", p))
+		}
+		defer f.Close()
+		co, err := Parse(f)
+		if err != nil {
+			return nil, slogerr.With(err, "codeowners", p) //nolint:wrapcheck
+		}
+		return co, nil
+	}
+	return nil, slogerr.With(errNotFound, "root_dir", rootDir) //nolint:wrapcheck
+}
+
+// Parse parses a CODEOWNERS file.
+func Parse(r io.Reader) (*CodeOwners, error) {
+	co := &CodeOwners{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := splitFields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		pattern, err := compile(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("parse a pattern of CODEOWNERS: %w", slogerr.With(err, "pattern", fields[0]))
+		}
+		co.rules = append(co.rules, &rule{
+			pattern: pattern,
+			owners:  fields[1:],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read CODEOWNERS: %w", err)
+	}
+	return co, nil
+}
+
+// splitFields splits a line of CODEOWNERS into a pattern and owners.
+// Fields are separated by whitespaces, and a field starting with "#" starts a comment.
+// A backslash escapes the next character, so "\ " is a space in a pattern and "\#" isn't a comment.
+// Escapes are kept in fields and are resolved by compile.
+func splitFields(line string) []string {
+	var fields []string
+	var field strings.Builder
+	inField := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			field.WriteByte(c)
+			field.WriteByte(line[i+1])
+			i++
+			inField = true
+		case c == ' ' || c == '\t':
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		case c == '#' && !inField:
+			return fields
+		default:
+			field.WriteByte(c)
+			inField = true
+		}
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// Owners returns owners of a file.
+// The file path must be a slash separated relative path from the repository root.
+// As the last matching pattern takes the most precedence, owners of the last matching rule are returned.
+// If no rule matches, nil is returned.
+func (co *CodeOwners) Owners(p string) []string {
+	p = strings.TrimPrefix(p, "./")
+	for i := len(co.rules) - 1; i >= 0; i-- {
+		r := co.rules[i]
+		if r.pattern.MatchString(p) {
+			return r.owners
+		}
+	}
+	return nil
+}
+
+// compile converts a pattern of CODEOWNERS to a regular expression.
+// The syntax follows gitignore except for negation, which CODEOWNERS doesn't support.
+func compile(pattern string) (*regexp.Regexp, error) {
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	dir := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	// "docs/*" matches files in docs but doesn't match files in subdirectories of docs.
+	directChildren := strings.HasSuffix(pattern, "/*") && !strings.HasSuffix(pattern, "**/*")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// "**/" matches zero or more directories.
+					i++
+					b.WriteString("(?:.*/)?")
+					continue
+				}
+				b.WriteString(".*")
+				continue
+			}
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		case '\\':
+			// An escaped character is matched literally.
+			if i+1 < len(pattern) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(pattern[i])))
+				continue
+			}
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	switch {
+	case dir:
+		// A pattern ending with a slash matches only directories, so it matches files under the directory.
+		b.WriteString("/.*$")
+	case directChildren:
+		b.WriteString("$")
+	default:
+		// A pattern matches a file or files under a directory.
+		b.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(b.String()) //nolint:wrapcheck
+}
@@ -0,0 +1,162 @@
+package codeowners_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/codeowners"
+	"github.com/spf13/afero"
+)
+
+const codeOwners = `# comment
+*       @org/default
+*.js    @org/js # inline comment
+/build/logs/ @org/build
+apps/   @org/apps
+docs/*  docs@example.com
+/scripts/**/test.sh @org/qa
+/config.yaml
+/my\ file.txt @org/space
+\#notes.txt @org/hash
+/tabs.txt	@org/tabs	#comment @org/ignored
+/hash.txt @org/hash-a #@org/ignored
+`
+
+func TestCodeOwners_Owners(t *testing.T) {
+	t.Parallel()
+	co, err := codeowners.Parse(strings.NewReader(codeOwners))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []struct {
+		name string
+		path string
+		exp  []string
+	}{
+		{
+			name: "default",
+			path: "README.md",
+			exp:  []string{"@org/default"},
+		},
+		{
+			name: "extension",
+			path: "foo/bar.js",
+			exp:  []string{"@org/js"},
+		},
+		{
+			name: "anchored directory",
+			path: "build/logs/2024/out.log",
+			exp:  []string{"@org/build"},
+		},
+		{
+			name: "anchored directory doesn't match nested directory",
+			path: "foo/build/logs/out.log",
+			exp:  []string{"@org/default"},
+		},
+		{
+			name: "directory at any level",
+			path: "foo/apps/main.go",
+			exp:  []string{"@org/apps"},
+		},
+		{
+			name: "direct children",
+			path: "docs/index.md",
+			exp:  []string{"docs@example.com"},
+		},
+		{
+			name: "direct children doesn't match subdirectories",
+			path: "docs/guides/index.md",
+			exp:  []string{"@org/default"},
+		},
+		{
+			name: "double star",
+			path: "scripts/ci/lint/test.sh",
+			exp:  []string{"@org/qa"},
+		},
+		{
+			name: "double star matches zero directories",
+			path: "scripts/test.sh",
+			exp:  []string{"@org/qa"},
+		},
+		{
+			name: "no owner",
+			path: "config.yaml",
+			exp:  []string{},
+		},
+		{
+			name: "escaped space",
+			path: "my file.txt",
+			exp:  []string{"@org/space"},
+		},
+		{
+			name: "escaped hash",
+			path: "foo/#notes.txt",
+			exp:  []string{"@org/hash"},
+		},
+		{
+			name: "comment after a tab",
+			path: "tabs.txt",
+			exp:  []string{"@org/tabs"},
+		},
+		{
+			name: "comment without a space",
+			path: "hash.txt",
+			exp:  []string{"@org/hash-a"},
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(d.exp, co.Owners(d.path)); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+	fs := afero.NewMemMapFs()
+	if _, err := codeowners.Read(fs, "/workspace"); err == nil {
+		t.Fatal("error must be returned if CODEOWNERS isn't found")
+	}
+	if err := afero.WriteFile(fs, "/workspace/docs/CODEOWNERS", []byte("* @org/docs\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := afero.WriteFile(fs, "/workspace/.github/CODEOWNERS", []byte("* @org/github\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	co, err := codeowners.Read(fs, "/workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"@org/github"}, co.Owners("README.md")); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestFindRoot(t *testing.T) {
+	t.Parallel()
+	fs := afero.NewMemMapFs()
+	if err := fs.MkdirAll("/workspace/.git", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.MkdirAll("/workspace/config/lintnet", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	root, err := codeowners.FindRoot(fs, "/workspace/config/lintnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != "/workspace" {
+		t.Fatalf("got %q, wanted %q", root, "/workspace")
+	}
+	root, err = codeowners.FindRoot(fs, "/tmp/foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != "/tmp/foo" {
+		t.Fatalf("got %q, wanted %q if the directory isn't in a Git repository", root, "/tmp/foo")
+	}
+}
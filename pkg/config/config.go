@@ -32,6 +32,9 @@ type Config struct {
 	Outputs         Outputs                   `json:"outputs,omitempty"`
 	ModuleArchives  map[string]*ModuleArchive `json:"module_archives,omitempty"`
 	IgnoredPatterns []string                  `json:"ignore_patterns,omitempty"`
+	CodeOwners      bool                      `json:"codeowners,omitempty"`
+	FileTypes       FileTypes                 `json:"file_types,omitempty"`
+	MaxDataFileSize int64                     `json:"max_data_file_size,omitempty"`
 }
 
 func (c *Config) setErrorLevel(errLevel string) error {
@@ -78,6 +81,19 @@ type RawConfig struct {
 	IgnoredDirs     []string     `json:"ignored_dirs,omitempty"`
 	Targets         []*RawTarget `json:"targets"`
 	Outputs         Outputs      `json:"outputs,omitempty"`
+	// CodeOwners enables to set owners of data files to errors based on CODEOWNERS.
+	CodeOwners bool `json:"codeowners,omitempty"`
+	// JSONCFiles is glob patterns of JSON files allowing comments and trailing commas.
+	// Patterns are relative to the configuration file.
+	JSONCFiles []string `json:"jsonc_files,omitempty"`
+	// FileTypes maps glob patterns of data files to file types.
+	// FileTypes of targets take precedence over FileTypes.
+	FileTypes FileTypes `json:"file_types,omitempty"`
+	// MaxDataFileSize is the maximum size of data files in bytes.
+	// Larger files are skipped with warnings, which are always shown regardless of ShownErrorLevel.
+	// If MaxDataFileSize is 0, the default value is used.
+	// If MaxDataFileSize is negative, the size isn't limited.
+	MaxDataFileSize int64 `json:"max_data_file_size,omitempty"`
 }
 
 func (rc *RawConfig) GetTarget(targetID string) (*RawTarget, error) {
@@ -92,8 +108,11 @@ func (rc *RawConfig) GetTarget(targetID string) (*RawTarget, error) {
 // Parse processes a raw configuration.
 func (rc *RawConfig) Parse() (*Config, error) {
 	cfg := &Config{
-		Targets: make([]*Target, len(rc.Targets)),
-		Outputs: rc.Outputs,
+		Targets:         make([]*Target, len(rc.Targets)),
+		Outputs:         rc.Outputs,
+		CodeOwners:      rc.CodeOwners,
+		FileTypes:       rc.FileTypes.merge(rc.JSONCFiles),
+		MaxDataFileSize: rc.MaxDataFileSize,
 	}
 	cfg.setIgnoredPatterns(rc.IgnoredDirs)
 
@@ -207,3 +207,30 @@ func TestRawConfig_Parse(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestRawConfig_Parse_fileTypes(t *testing.T) {
+	t.Parallel()
+	rc := &config.RawConfig{}
+	if err := json.Unmarshal([]byte(`{
+		"jsonc_files": ["**/.babelrc", "foo.json"],
+		"file_types": {
+			"foo.json": "json",
+			"**/*.yaml.tmpl": {"type": "plain_text"}
+		},
+		"targets": []
+	}`), rc); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := rc.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := config.FileTypes{
+		"**/.babelrc":    {Type: "jsonc"},
+		"foo.json":       {Type: "json"},
+		"**/*.yaml.tmpl": {Type: "plain_text"},
+	}
+	if diff := cmp.Diff(exp, cfg.FileTypes); diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// FileTypes maps glob patterns of data files to file types.
+// Glob patterns are relative to the configuration file.
+// e.g.
+//
+//	{
+//	  "**/.babelrc": "json",
+//	  "**/*.yaml.tmpl": "plain_text"
+//	}
+type FileTypes map[string]*FileType
+
+// FileType is a file type of data files.
+// FileType is either a string or an object in a configuration file.
+type FileType struct {
+	// Type is a file type such as json and yaml.
+	// If Type is plain_text, data files aren't parsed.
+	Type string `json:"type"`
+	// CSV is options of csv and tsv.
+	CSV *domain.CSVOption `json:"csv,omitempty"`
+	// YAML is options of yaml.
+	YAML *domain.YAMLOption `json:"yaml,omitempty"`
+	// Jsonnet is options of jsonnet.
+	Jsonnet *domain.JsonnetOption `json:"jsonnet,omitempty"`
+}
+
+func (ft *FileType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		ft.Type = s
+		return nil
+	}
+	a := struct {
+		Type    string                `json:"type"`
+		CSV     *domain.CSVOption     `json:"csv"`
+		YAML    *domain.YAMLOption    `json:"yaml"`
+		Jsonnet *domain.JsonnetOption `json:"jsonnet"`
+	}{}
+	if err := json.Unmarshal(b, &a); err != nil {
+		return fmt.Errorf("file type must be either a string or an object: %w", err)
+	}
+	ft.Type = a.Type
+	ft.CSV = a.CSV
+	ft.YAML = a.YAML
+	ft.Jsonnet = a.Jsonnet
+	return nil
+}
+
+// ParseOption returns options to parse data files.
+// If no option is set, nil is returned.
+func (ft *FileType) ParseOption() *domain.ParseOption {
+	if ft.CSV == nil && ft.YAML == nil && ft.Jsonnet == nil {
+		return nil
+	}
+	return &domain.ParseOption{
+		CSV:     ft.CSV,
+		YAML:    ft.YAML,
+		Jsonnet: ft.Jsonnet,
+	}
+}
+
+// merge returns file types merging jsoncFiles.
+// jsoncFiles are mapped to jsonc unless the same pattern is set in fts.
+func (fts FileTypes) merge(jsoncFiles []string) FileTypes {
+	if len(jsoncFiles) == 0 {
+		return fts
+	}
+	m := make(FileTypes, len(fts)+len(jsoncFiles))
+	for _, pattern := range jsoncFiles {
+		m[pattern] = &FileType{
+			Type: "jsonc",
+		}
+	}
+	for pattern, ft := range fts {
+		m[pattern] = ft
+	}
+	return m
+}
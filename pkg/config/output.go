@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -27,24 +28,69 @@ func (os Outputs) Preprocess(modules map[string]*ModuleArchive) error {
 
 type Output struct {
 	ID string `json:"id"`
-	// text/template, html/template, jsonnet
+	// text/template, html/template, jsonnet, command, or a built-in format such as checkstyle, rdjson, rdjsonl, gitlab-codequality, html-report, and ndjson
 	Renderer string `json:"renderer"`
 	// path to a template file
 	Template string `json:"template"`
 	// parameter
 	Config map[string]any `json:"config"`
-	// Transform is a transformation file path.
+	// Transform is a list of transformation file paths.
 	// A transformation file transforms lint results before the results are outputted.
+	// Transformation files are applied in order, and each transformation file receives the output of the previous one.
 	// A transformation file must be a Jsonnet.
 	// A file path must be an absolute path, a relative path from the configuration file, or a module path.
 	// e.g.
 	// transform.jsonnnet
 	// /home/foo/.lintent/transform.jsonnnet
 	// github_archive/github.com/lintnet/modules/transform.jsonnet@32ca3be646ec5b5861aab72fed30cd71f6eba9bf:v0.1.2
-	Transform string `json:"transform"`
+	Transform Transforms `json:"transform"`
+	// StringOutput is used only if the renderer is jsonnet.
+	// If StringOutput is true, the template must be evaluated to a string and the string is outputted as is like `jsonnet -S`.
+	// Otherwise, the template is evaluated to JSON.
+	StringOutput bool `json:"string_output,omitempty"`
+	// Command is used only if the renderer is command.
+	// The result is passed to the command's standard input as JSON.
+	Command *Command `json:"command,omitempty"`
 
-	TemplateModule  *Module `json:"-"`
-	TransformModule *Module `json:"-"`
+	TemplateModule *Module `json:"-"`
+	// TransformModules is a list of modules of Transform.
+	// The index is same as Transform.
+	// If a transformation file isn't a module, the element is nil.
+	TransformModules []*Module `json:"-"`
+}
+
+// Command is an external command which receives lint results.
+type Command struct {
+	// Args is the command and its arguments.
+	// The command is run in the directory of the configuration file.
+	Args []string `json:"args"`
+	// Env is environment variables passed to the command in addition to lintnet's environment variables.
+	Env map[string]string `json:"env,omitempty"`
+	// Timeout is the timeout in seconds.
+	// If Timeout is 0, the command never times out.
+	Timeout int `json:"timeout,omitempty"`
+}
+
+// Transforms is a list of transformation file paths.
+// Transforms is either a string or a list of strings in a configuration file.
+type Transforms []string
+
+func (ts *Transforms) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s == "" {
+			*ts = nil
+			return nil
+		}
+		*ts = Transforms{s}
+		return nil
+	}
+	var arr []string
+	if err := json.Unmarshal(b, &arr); err != nil {
+		return fmt.Errorf("transform must be either a string or a list of strings: %w", err)
+	}
+	*ts = arr
+	return nil
 }
 
 func (o *Output) Preprocess(modules map[string]*ModuleArchive) error {
@@ -56,12 +102,16 @@ func (o *Output) Preprocess(modules map[string]*ModuleArchive) error {
 		o.TemplateModule = m
 		modules[m.Archive.String()] = m.Archive
 	}
-	if strings.HasPrefix(o.Transform, "github_archive/github.com/") {
-		m, err := ParseImport(o.Transform)
+	o.TransformModules = make([]*Module, len(o.Transform))
+	for i, transform := range o.Transform {
+		if !strings.HasPrefix(transform, "github_archive/github.com/") {
+			continue
+		}
+		m, err := ParseImport(transform)
 		if err != nil {
 			return fmt.Errorf("parse a module path: %w", err)
 		}
-		o.TransformModule = m
+		o.TransformModules[i] = m
 		modules[m.Archive.String()] = m.Archive
 	}
 	return nil
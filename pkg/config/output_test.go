@@ -0,0 +1,60 @@
+package config_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/config"
+)
+
+func TestTransforms_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name  string
+		s     string
+		exp   config.Transforms
+		isErr bool
+	}{
+		{
+			name: "string",
+			s:    `"transform.jsonnet"`,
+			exp:  config.Transforms{"transform.jsonnet"},
+		},
+		{
+			name: "empty string",
+			s:    `""`,
+		},
+		{
+			name: "list",
+			s:    `["filter.jsonnet", "github_archive/github.com/lintnet/modules/transform.jsonnet@32ca3be646ec5b5861aab72fed30cd71f6eba9bf:v0.1.2"]`,
+			exp: config.Transforms{
+				"filter.jsonnet",
+				"github_archive/github.com/lintnet/modules/transform.jsonnet@32ca3be646ec5b5861aab72fed30cd71f6eba9bf:v0.1.2",
+			},
+		},
+		{
+			name:  "invalid",
+			s:     `{"path": "transform.jsonnet"}`,
+			isErr: true,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			var ts config.Transforms
+			if err := json.Unmarshal([]byte(d.s), &ts); err != nil {
+				if d.isErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			if d.isErr {
+				t.Fatal("error must be returned")
+			}
+			if diff := cmp.Diff(d.exp, ts); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
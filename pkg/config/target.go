@@ -7,6 +7,7 @@ type Target struct {
 	Modules        []*ModuleGlob             `json:"modules,omitempty"`
 	ModuleArchives map[string]*ModuleArchive `json:"module_archives,omitempty"`
 	DataFiles      []*DataFile               `json:"data_files,omitempty"`
+	FileTypes      FileTypes                 `json:"file_types,omitempty"`
 }
 
 type RawTarget struct {
@@ -15,6 +16,7 @@ type RawTarget struct {
 	LintGlobs    []*LintGlob  `json:"lint_files"`
 	Modules      []*RawModule `json:"modules"`
 	DataFiles    []string     `json:"data_files"`
+	FileTypes    FileTypes    `json:"file_types,omitempty"`
 }
 
 func (rt *RawTarget) Parse() (*Target, error) {
@@ -31,6 +33,7 @@ func (rt *RawTarget) Parse() (*Target, error) {
 		LintFiles:    rt.LintGlobs,
 		Modules:      make([]*ModuleGlob, len(rt.Modules)),
 		DataFiles:    dataFiles,
+		FileTypes:    rt.FileTypes,
 	}
 	archives := make(map[string]*ModuleArchive, len(rt.Modules))
 	for i, m := range rt.Modules {
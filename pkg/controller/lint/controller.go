@@ -24,7 +24,7 @@ type Controller struct {
 	moduleInstaller ModuleInstaller
 	importer        gojsonnet.Importer
 	param           *ParamController
-	dataFileParser  lint.DataFileParser
+	dataFileParser  *encoding.DataFileParser
 	linter          Linter
 	fileFinder      FileFinder
 	configReader    ConfigReader
@@ -51,6 +51,7 @@ func (m *MockModuleInstaller) Installs(ctx context.Context, logger *slog.Logger,
 
 type Linter interface {
 	Lint(targets []*filefind.Target) ([]*domain.Result, error)
+	LintStream(targets []*filefind.Target, handler lint.ResultHandler) error
 }
 
 type FileFinder interface {
@@ -63,7 +64,7 @@ type ParamController struct {
 }
 
 func NewController(param *ParamController, fs afero.Fs, stdout io.Writer, moduleInstaller ModuleInstaller, importer gojsonnet.Importer) *Controller {
-	dp := encoding.NewDataFileParser(fs)
+	dp := encoding.NewDataFileParser(fs, importer)
 	return &Controller{
 		param:           param,
 		fs:              fs,
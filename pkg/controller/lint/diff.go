@@ -0,0 +1,126 @@
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	"github.com/lintnet/lintnet/pkg/config"
+	"github.com/lintnet/lintnet/pkg/errlevel"
+	"github.com/lintnet/lintnet/pkg/log"
+	"github.com/lintnet/lintnet/pkg/module"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
+)
+
+type ParamDiff struct {
+	// OldFilePath and NewFilePath are file paths to results of `lintnet lint` in JSON format.
+	OldFilePath    string `json:"old_file_path,omitempty"`
+	NewFilePath    string `json:"new_file_path,omitempty"`
+	ErrorLevel     string `json:"error_level,omitempty"`
+	RootDir        string `json:"root_dir,omitempty"`
+	ConfigFilePath string `json:"config_file_path,omitempty"`
+	Output         string `json:"output,omitempty"`
+	Format         string `json:"format,omitempty"`
+	PWD            string `json:"pwd,omitempty"`
+}
+
+// Diff compares two results of `lintnet lint` and outputs new, fixed, and unchanged errors.
+// A configuration file is read only if an output id is specified.
+// If new errors are found, Diff returns an error with ExitCodeViolation.
+func (c *Controller) Diff(ctx context.Context, logger *slog.Logger, param *ParamDiff) error {
+	logger.Debug("parameter", "param", log.JSON(param))
+	outputter, err := c.diffOutputter(ctx, logger, param)
+	if err != nil {
+		return err
+	}
+
+	errLevel, err := getErrorLevel(param.ErrorLevel, errlevel.Error)
+	if err != nil {
+		return ecerror.Wrap(err, ExitCodeConfig)
+	}
+
+	oldResult, err := c.readResult(param.OldFilePath)
+	if err != nil {
+		return ecerror.Wrap(err, ExitCodeConfig)
+	}
+	newResult, err := c.readResult(param.NewFilePath)
+	if err != nil {
+		return ecerror.Wrap(err, ExitCodeConfig)
+	}
+
+	result := output.Compare(oldResult, newResult)
+	result.LintnetVersion = c.param.Version
+	result.Env = c.param.Env
+	if err := outputter.Output(result); err != nil {
+		return ecerror.Wrap(fmt.Errorf("output the result: %w", err), ExitCodeConfig)
+	}
+	failed, err := isFailed(result.Errors, errLevel)
+	if err != nil {
+		// Invalid error levels are in result files.
+		return ecerror.Wrap(err, ExitCodeConfig)
+	}
+	if failed {
+		return ecerror.Wrap(errors.New("new errors are found"), ExitCodeViolation)
+	}
+	return nil
+}
+
+// diffOutputter returns an outputter.
+// Modules used in outputs are installed.
+func (c *Controller) diffOutputter(ctx context.Context, logger *slog.Logger, param *ParamDiff) (output.Outputter, error) {
+	outputParam := &output.ParamGet{
+		RootDir: param.RootDir,
+		Output:  param.Output,
+		Format:  param.Format,
+	}
+	if param.Output == "" {
+		outputter, err := c.outputGetter.Get(nil, outputParam, param.PWD)
+		if err != nil {
+			return nil, ecerror.Wrap(fmt.Errorf("get an outputter: %w", err), ExitCodeConfig)
+		}
+		return outputter, nil
+	}
+
+	rawCfg := &config.RawConfig{}
+	if err := c.configReader.Read(param.ConfigFilePath, rawCfg); err != nil {
+		return nil, ecerror.Wrap(fmt.Errorf("read a configuration file: %w", err), ExitCodeConfig)
+	}
+	moduleArchives := map[string]*config.ModuleArchive{}
+	if err := rawCfg.Outputs.Preprocess(moduleArchives); err != nil {
+		return nil, ecerror.Wrap(fmt.Errorf("parse outputs: %w", err), ExitCodeConfig)
+	}
+	if err := c.moduleInstaller.Installs(ctx, logger, &module.ParamInstall{
+		BaseDir: filepath.Join(param.RootDir, "modules"),
+	}, moduleArchives); err != nil {
+		return nil, ecerror.Wrap(fmt.Errorf("install modules: %w", err), ExitCodeModuleInstall)
+	}
+
+	cfgDir := filepath.Dir(rawCfg.FilePath)
+	if !filepath.IsAbs(cfgDir) {
+		cfgDir = filepath.Join(param.PWD, cfgDir)
+	}
+	outputter, err := c.outputGetter.Get(rawCfg.Outputs, outputParam, filepath.Clean(cfgDir))
+	if err != nil {
+		return nil, ecerror.Wrap(fmt.Errorf("get an outputter: %w", err), ExitCodeConfig)
+	}
+	return outputter, nil
+}
+
+// readResult reads a result of `lintnet lint` in JSON format.
+func (c *Controller) readResult(p string) (*output.Output, error) {
+	b, err := afero.ReadFile(c.fs, p)
+	if err != nil {
+		return nil, fmt.Errorf("read a result file: %w", slogerr.With(err, "result_file", p))
+	}
+	result := &output.Output{}
+	if err := json.Unmarshal(b, result); err != nil {
+		return nil, fmt.Errorf("parse a result file as JSON: %w", slogerr.With(err, "result_file", p))
+	}
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package lint
+
+import (
+	"errors"
+
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
+)
+
+// Exit codes of `lintnet lint`.
+// These values are stable, so scripts can distinguish the reason of failure.
+const (
+	// ExitCodeViolation means lint files found errors whose levels are higher than or equal to the error level.
+	// Data files failing to be parsed are also violations because they are problems of data files.
+	ExitCodeViolation = 1
+	// ExitCodeConfig means the configuration or the usage is invalid, or outputs failed.
+	// Failures of outputs include renderers, templates, transforms, and commands.
+	ExitCodeConfig = 2
+	// ExitCodeModuleInstall means lintnet failed to install modules.
+	ExitCodeModuleInstall = 3
+	// ExitCodeEvaluation means lintnet failed to evaluate lint files or lint files returned invalid results such as invalid error levels.
+	ExitCodeEvaluation = 4
+)
+
+// resultError returns an error according to lint results.
+// Evaluation errors take precedence over violations unless evaluation errors are treated as violations.
+// Errors of data files aren't evaluation errors, so they fail the lint as violations.
+// If evaluation errors are treated as violations, they are included in errors, so the lint fails as violations.
+func resultError(failed bool, numEvaluationErrors int, evaluationErrorAsViolation bool) error {
+	if numEvaluationErrors > 0 && !evaluationErrorAsViolation {
+		return ecerror.Wrap(errors.New("failed to evaluate lint files"), ExitCodeEvaluation)
+	}
+	if failed {
+		return ecerror.Wrap(errors.New("lint failed"), ExitCodeViolation)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
+)
+
+func Test_resultError(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name                       string
+		failed                     bool
+		numEvaluationErrors        int
+		evaluationErrorAsViolation bool
+		exp                        int
+	}{
+		{
+			name: "pass",
+			exp:  0,
+		},
+		{
+			name:   "violation",
+			failed: true,
+			exp:    ExitCodeViolation,
+		},
+		{
+			name:                "evaluation error",
+			failed:              true,
+			numEvaluationErrors: 1,
+			exp:                 ExitCodeEvaluation,
+		},
+		{
+			name:                       "evaluation error as violation",
+			failed:                     true,
+			numEvaluationErrors:        1,
+			evaluationErrorAsViolation: true,
+			exp:                        ExitCodeViolation,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			err := resultError(d.failed, d.numEvaluationErrors, d.evaluationErrorAsViolation)
+			if code := ecerror.GetExitCode(err); code != d.exp {
+				t.Fatalf("got %d, wanted %d", code, d.exp)
+			}
+		})
+	}
+}
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
+	"time"
 
+	"github.com/lintnet/lintnet/pkg/codeowners"
 	"github.com/lintnet/lintnet/pkg/config"
 	"github.com/lintnet/lintnet/pkg/errlevel"
 	"github.com/lintnet/lintnet/pkg/filefilter"
+	"github.com/lintnet/lintnet/pkg/filefind"
 	"github.com/lintnet/lintnet/pkg/log"
 	"github.com/lintnet/lintnet/pkg/module"
 	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
 )
 
 type ParamLint struct {
@@ -23,8 +27,15 @@ type ParamLint struct {
 	TargetID        string   `json:"target_id,omitempty"`
 	FilePaths       []string `json:"file_paths,omitempty"`
 	Output          string   `json:"output,omitempty"`
+	Format          string   `json:"format,omitempty"`
 	OutputSuccess   bool     `json:"output_success,omitempty"`
-	PWD             string   `json:"pwd,omitempty"`
+	// EvaluationErrorAsViolation treats errors of lint file evaluation as lint violations.
+	EvaluationErrorAsViolation bool `json:"evaluation_error_as_violation,omitempty"`
+	// ShowExcluded outputs errors excluded by lint files.
+	ShowExcluded bool `json:"show_excluded,omitempty"`
+	// Owners filters errors by owners in CODEOWNERS.
+	Owners []string `json:"owners,omitempty"`
+	PWD    string   `json:"pwd,omitempty"`
 }
 
 func (p *ParamLint) FilterParam() *filefilter.Param {
@@ -40,16 +51,18 @@ func (p *ParamLint) OutputterParam() *output.ParamGet {
 	return &output.ParamGet{
 		RootDir: p.RootDir,
 		Output:  p.Output,
+		Format:  p.Format,
 	}
 }
 
 // Lint lints files.
 func (c *Controller) Lint(ctx context.Context, logger *slog.Logger, param *ParamLint) error { //nolint:cyclop,funlen
+	startTime := time.Now()
 	logger.Debug("parameter", "param", log.JSON(param))
 	// Find and read a configuration file.
 	rawCfg := &config.RawConfig{}
 	if err := c.configReader.Read(param.ConfigFilePath, rawCfg); err != nil {
-		return fmt.Errorf("read a configuration file: %w", err)
+		return ecerror.Wrap(fmt.Errorf("read a configuration file: %w", err), ExitCodeConfig)
 	}
 
 	logger.Debug("read config", "config", log.JSON(rawCfg))
@@ -58,7 +71,7 @@ func (c *Controller) Lint(ctx context.Context, logger *slog.Logger, param *Param
 		// If a target id is specified, gets a target from the configuration file by the target id.
 		target, err := rawCfg.GetTarget(param.TargetID)
 		if err != nil {
-			return fmt.Errorf("get a target from configuration file by target id: %w", err)
+			return ecerror.Wrap(fmt.Errorf("get a target from configuration file by target id: %w", err), ExitCodeConfig)
 		}
 		rawCfg.Targets = []*config.RawTarget{target}
 	}
@@ -66,7 +79,7 @@ func (c *Controller) Lint(ctx context.Context, logger *slog.Logger, param *Param
 	// Parse the configuration file.
 	cfg, err := rawCfg.Parse()
 	if err != nil {
-		return fmt.Errorf("parse a configuration file: %w", err)
+		return ecerror.Wrap(fmt.Errorf("parse a configuration file: %w", err), ExitCodeConfig)
 	}
 
 	logger.Debug("parse config", "config", log.JSON(cfg), "raw_config", log.JSON(rawCfg))
@@ -81,32 +94,52 @@ func (c *Controller) Lint(ctx context.Context, logger *slog.Logger, param *Param
 	// Get an outputter.
 	outputter, err := c.outputGetter.Get(cfg.Outputs, param.OutputterParam(), cfgDir)
 	if err != nil {
-		return fmt.Errorf("get an outputter: %w", err)
+		return ecerror.Wrap(fmt.Errorf("get an outputter: %w", err), ExitCodeConfig)
 	}
 
 	errLevel, err := getErrorLevel(param.ErrorLevel, cfg.ErrorLevel)
 	if err != nil {
-		return err
+		return ecerror.Wrap(err, ExitCodeConfig)
 	}
 
 	shownErrLevel, err := getErrorLevel(param.ShownErrorLevel, cfg.ShownErrorLevel)
 	if err != nil {
-		return err
+		return ecerror.Wrap(err, ExitCodeConfig)
 	}
 
+	var owners *ownerAssigner
+	if cfg.CodeOwners || len(param.Owners) > 0 {
+		repoRootDir, err := codeowners.FindRoot(c.fs, cfgDir)
+		if err != nil {
+			return ecerror.Wrap(fmt.Errorf("find the repository root directory: %w", err), ExitCodeConfig)
+		}
+		co, err := codeowners.Read(c.fs, repoRootDir)
+		if err != nil {
+			return ecerror.Wrap(fmt.Errorf("read CODEOWNERS: %w", err), ExitCodeConfig)
+		}
+		owners = &ownerAssigner{
+			codeOwners: co,
+			rootDir:    repoRootDir,
+			baseDir:    cfgDir,
+			owners:     param.Owners,
+		}
+	}
+
+	c.dataFileParser.SetMaxSize(cfg.MaxDataFileSize)
+
 	modRootDir := filepath.Join(param.RootDir, "modules")
 
 	// Install modules.
 	if err := c.moduleInstaller.Installs(ctx, logger, &module.ParamInstall{
 		BaseDir: modRootDir,
 	}, cfg.ModuleArchives); err != nil {
-		return fmt.Errorf("install modules: %w", err)
+		return ecerror.Wrap(fmt.Errorf("install modules: %w", err), ExitCodeModuleInstall)
 	}
 
 	// Find targets, which are pairs of lint files and data files.
 	targets, err := c.fileFinder.Find(logger, cfg, modRootDir, cfgDir)
 	if err != nil {
-		return fmt.Errorf("find files: %w", err)
+		return ecerror.Wrap(fmt.Errorf("find files: %w", err), ExitCodeConfig)
 	}
 
 	logger.Debug("found files", "targets", log.JSON(targets))
@@ -117,17 +150,38 @@ func (c *Controller) Lint(ctx context.Context, logger *slog.Logger, param *Param
 		// If files are specified, filters targets by the files.
 		targets = filefilter.FilterTargetsByFilePaths(filterParam, targets)
 		logger.Debug("filtered targets by given files", "filter_param", log.JSON(filterParam), "targets", log.JSON(targets))
+		if param.TargetID != "" {
+			// Data files are replaced with given files, so file types need to be set again.
+			if err := filefind.SetFileTypes(targets, cfg.Targets[0].FileTypes, cfg.FileTypes, cfgDir); err != nil {
+				return ecerror.Wrap(fmt.Errorf("set file types: %w", err), ExitCodeConfig)
+			}
+		}
+	}
+
+	outputParam := &ParamOutput{
+		ErrLevel:                   errLevel,
+		ShownErrLevel:              shownErrLevel,
+		OutputSuccess:              param.OutputSuccess,
+		EvaluationErrorAsViolation: param.EvaluationErrorAsViolation,
+		ShowExcluded:               param.ShowExcluded,
+		owners:                     owners,
+	}
+
+	if streamer, ok := outputter.(output.Streamer); ok {
+		// Output errors as soon as lint files are evaluated.
+		return c.lintStream(logger, targets, streamer, outputParam, startTime)
 	}
 
 	// Lint targets.
 	results, err := c.linter.Lint(targets)
 	if err != nil {
-		return fmt.Errorf("lint targets: %w", err)
+		return ecerror.Wrap(fmt.Errorf("lint targets: %w", err), ExitCodeEvaluation)
 	}
 	logger.Debug("linted", "config", log.JSON(cfg), "results", log.JSON(results), "targets", log.JSON(targets))
 
 	// Output results.
-	return c.Output(logger, errLevel, shownErrLevel, results, []Outputter{outputter}, param.OutputSuccess)
+	outputParam.Elapsed = time.Since(startTime)
+	return c.Output(logger, results, []Outputter{outputter}, outputParam)
 }
 
 func getErrorLevel(errLevel string, defaultErrorLevel errlevel.Level) (errlevel.Level, error) {
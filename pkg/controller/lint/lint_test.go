@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-jsonnet"
 	"github.com/lintnet/lintnet/pkg/controller/lint"
 	"github.com/lintnet/lintnet/pkg/testutil"
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
 )
 
 func TestController_Lint(t *testing.T) { //nolint:funlen,gocognit,cyclop
@@ -25,6 +26,10 @@ func TestController_Lint(t *testing.T) { //nolint:funlen,gocognit,cyclop
 		dirs     []string
 		contents map[string]string
 		exp      string
+		// exitCode is the expected exit code if isErr is true.
+		exitCode int
+		// If ndjson is true, the output is parsed as newline delimited JSON and compared with a JSON array.
+		ndjson bool
 	}{
 		{
 			name: "normal",
@@ -47,6 +52,268 @@ func TestController_Lint(t *testing.T) { //nolint:funlen,gocognit,cyclop
 			contents: map[string]string{},
 			exp:      "testdata/result.json",
 		},
+		{
+			name: "ndjson",
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				Format:         "ndjson",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+			dirs:     []string{},
+			contents: map[string]string{},
+			exp:      "testdata/result_ndjson.json",
+			ndjson:   true,
+		},
+		{
+			name: "show excluded",
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				ShowExcluded:   true,
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello_excluded.jsonnet",
+			},
+			dirs:     []string{},
+			contents: map[string]string{},
+			exp:      "testdata/result_excluded.json",
+		},
+		{
+			name: "combine with a broken data file",
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                           "testdata/lintnet_combine.jsonnet",
+				"/home/foo/workspace/foo.json":              "testdata/foo.json",
+				"/home/foo/workspace/broken.jsonnet":        "testdata/broken.jsonnet",
+				"/home/foo/workspace/hello_combine.jsonnet": "testdata/hello_combine.jsonnet",
+			},
+			dirs:     []string{},
+			contents: map[string]string{},
+			// The error of the broken data file doesn't prevent other data files from being linted.
+			// Errors of data files are lint violations, not evaluation errors.
+			exp: "testdata/result_combine.json",
+		},
+		{
+			// Data files failing to be parsed are lint violations, not evaluation errors.
+			name: "broken data file",
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet_broken.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/broken.json":   "testdata/broken.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+		},
+		{
+			// Skipped data files and data files failing to be parsed are reported once per target.
+			name: "combine and non combine files",
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                           "testdata/lintnet_mixed.jsonnet",
+				"/home/foo/workspace/foo.json":              "testdata/foo.json",
+				"/home/foo/workspace/bin.json":              "testdata/bin.json",
+				"/home/foo/workspace/broken.json":           "testdata/broken.json",
+				"/home/foo/workspace/hello.jsonnet":         "testdata/hello.jsonnet",
+				"/home/foo/workspace/hello_combine.jsonnet": "testdata/hello_combine.jsonnet",
+			},
+			exp: "testdata/result_mixed.json",
+		},
+		{
+			name:  "invalid error level",
+			isErr: true,
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				ErrorLevel:     "foo",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+			exitCode: lint.ExitCodeConfig,
+		},
+		{
+			name:  "invalid shown error level",
+			isErr: true,
+			param: &lint.ParamLint{
+				RootDir:         "/home/foo/.local/share/lintnet",
+				DataRootDir:     "/home/foo/workspace",
+				ConfigFilePath:  "",
+				PWD:             "/home/foo/workspace",
+				ShownErrorLevel: "foo",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+			exitCode: lint.ExitCodeConfig,
+		},
+		{
+			name:  "unknown target",
+			isErr: true,
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				TargetID:       "foo",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+			exitCode: lint.ExitCodeConfig,
+		},
+		{
+			name:  "unknown format",
+			isErr: true,
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				Format:         "foo",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+			exitCode: lint.ExitCodeConfig,
+		},
+		{
+			name:  "unknown output",
+			isErr: true,
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				Output:         "foo",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+			exitCode: lint.ExitCodeConfig,
+		},
+		{
+			name:  "output failure",
+			isErr: true,
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				Output:         "notify",
+				OutputSuccess:  true,
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                   "testdata/lintnet_command.jsonnet",
+				"/home/foo/workspace/foo.json":      "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet": "testdata/hello.jsonnet",
+			},
+			exitCode: lint.ExitCodeConfig,
+		},
+		{
+			name:  "transform failure",
+			isErr: true,
+			param: &lint.ParamLint{
+				RootDir:        "/home/foo/.local/share/lintnet",
+				DataRootDir:    "/home/foo/workspace",
+				ConfigFilePath: "",
+				PWD:            "/home/foo/workspace",
+				Output:         "broken",
+			},
+			paramC: &lint.ParamController{
+				Version: "v0.3.0",
+				Env:     "darwin/arm64",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":                       "testdata/lintnet_transform.jsonnet",
+				"/home/foo/workspace/result.tpl":        "testdata/result.tpl",
+				"/home/foo/workspace/transform.jsonnet": "testdata/transform.jsonnet",
+				"/home/foo/workspace/foo.json":          "testdata/foo.json",
+				"/home/foo/workspace/hello.jsonnet":     "testdata/hello.jsonnet",
+			},
+			exitCode: lint.ExitCodeConfig,
+		},
 	}
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
@@ -78,17 +345,28 @@ func TestController_Lint(t *testing.T) { //nolint:funlen,gocognit,cyclop
 			}
 			if err := ctrl.Lint(ctx, logger, d.param); err != nil {
 				if d.isErr {
-					return
+					// Configuration and usage errors must never be confused with lint violations.
+					if code := ecerror.GetExitCode(err); code != d.exitCode {
+						t.Fatalf("exit code: got %d, wanted %d: %v", code, d.exitCode, err)
+					}
+					if d.exp == "" {
+						return
+					}
+				} else if code := ecerror.GetExitCode(err); code != lint.ExitCodeViolation {
+					// Lint violations are expected in test data.
+					t.Fatalf("exit code: got %d, wanted %d: %v", code, lint.ExitCodeViolation, err)
 				}
-			}
-			if d.isErr {
+			} else if d.isErr {
 				t.Fatal("error must be returned")
 			}
 			if d.exp != "" {
 				var result any
-				if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+				if d.ndjson {
+					result = parseNDJSON(t, stdout.Bytes())
+				} else if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
 					t.Fatal(err)
 				}
+				removeElapsedTime(result)
 				if diff := cmp.Diff(exp, result); diff != "" {
 					t.Fatal(diff)
 				}
@@ -96,3 +374,35 @@ func TestController_Lint(t *testing.T) { //nolint:funlen,gocognit,cyclop
 		})
 	}
 }
+
+func parseNDJSON(t *testing.T, b []byte) []any {
+	t.Helper()
+	records := []any{}
+	for line := range bytes.Lines(b) {
+		var record any
+		if err := json.Unmarshal(line, &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// removeElapsedTime removes summary.elapsed_seconds from the result because it isn't deterministic.
+func removeElapsedTime(result any) {
+	if records, ok := result.([]any); ok {
+		for _, record := range records {
+			removeElapsedTime(record)
+		}
+		return
+	}
+	m, ok := result.(map[string]any)
+	if !ok {
+		return
+	}
+	summary, ok := m["summary"].(map[string]any)
+	if !ok {
+		return
+	}
+	delete(summary, "elapsed_seconds")
+}
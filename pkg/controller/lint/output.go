@@ -2,11 +2,14 @@ package lint
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/lintnet/lintnet/pkg/domain"
 	"github.com/lintnet/lintnet/pkg/errlevel"
 	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
 	"github.com/suzuki-shunsuke/slog-error/slogerr"
 )
 
@@ -14,26 +17,53 @@ type Outputter interface {
 	Output(result *output.Output) error
 }
 
-func (c *Controller) Output(logger *slog.Logger, errLevel, shownErrLevel errlevel.Level, results []*domain.Result, outputters []Outputter, outputSuccess bool) error {
+type ParamOutput struct {
+	ErrLevel      errlevel.Level
+	ShownErrLevel errlevel.Level
+	OutputSuccess bool
+	// EvaluationErrorAsViolation treats errors of lint file evaluation as lint violations.
+	// Otherwise, they are treated as infrastructure failures and the exit code is ExitCodeEvaluation.
+	EvaluationErrorAsViolation bool
+	Elapsed                    time.Duration
+	// ShowExcluded outputs errors excluded by lint files.
+	// Excluded errors never fail the lint.
+	ShowExcluded bool
+	// owners sets owners to errors and filters errors by owners.
+	// If CODEOWNERS isn't enabled, owners is nil.
+	owners *ownerAssigner
+}
+
+func (c *Controller) Output(logger *slog.Logger, results []*domain.Result, outputters []Outputter, param *ParamOutput) error {
 	fes := &output.Output{
-		Errors:         output.FormatResults(logger, results, shownErrLevel),
+		Errors:         param.owners.Assign(output.FormatResults(logger, results, param.ShownErrLevel)),
 		LintnetVersion: c.param.Version,
 		Env:            c.param.Env,
 	}
-	failed, err := isFailed(fes.Errors, errLevel)
+	if param.ShowExcluded {
+		fes.Excluded = param.owners.Assign(output.FormatExcludedResults(results))
+	}
+	fes.Summary = output.NewSummary(results, fes.Errors, param.Elapsed)
+	failed, err := isFailed(fes.Errors, param.ErrLevel)
 	if err != nil {
-		return err
+		// Invalid error levels are returned by lint files, so this is an error of lint files, not outputs.
+		return ecerror.Wrap(err, ExitCodeEvaluation)
 	}
-	if !outputSuccess && len(fes.Errors) == 0 {
-		return nil
+	if !param.OutputSuccess && len(fes.Errors) == 0 && len(fes.Excluded) == 0 {
+		return resultError(failed, fes.Summary.NumEvaluationErrors, param.EvaluationErrorAsViolation)
 	}
+	var outputErrs []error
 	for _, outputter := range outputters {
 		if err := outputter.Output(fes); err != nil {
-			slogerr.WithError(logger, err).Error("output errors")
+			outputErrs = append(outputErrs, fmt.Errorf("output errors: %w", err))
 		}
 	}
-	if failed {
-		return errors.New("lint failed")
+	if len(outputErrs) > 0 {
+		// Failures of outputs such as notifications must be detected even if the lint passes.
+		// The lint result is logged because the exit code doesn't indicate it.
+		if err := resultError(failed, fes.Summary.NumEvaluationErrors, param.EvaluationErrorAsViolation); err != nil {
+			slogerr.WithError(logger, err).Error("lint result")
+		}
+		return ecerror.Wrap(errors.Join(outputErrs...), ExitCodeConfig)
 	}
-	return nil
+	return resultError(failed, fes.Summary.NumEvaluationErrors, param.EvaluationErrorAsViolation)
 }
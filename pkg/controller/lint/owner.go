@@ -0,0 +1,69 @@
+package lint
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/lintnet/lintnet/pkg/codeowners"
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// ownerAssigner sets owners of data files to errors based on CODEOWNERS.
+// If owners is specified, errors are filtered by owners.
+type ownerAssigner struct {
+	codeOwners *codeowners.CodeOwners
+	// rootDir is the root directory of the repository.
+	// CODEOWNERS is searched in rootDir, and patterns are relative to rootDir.
+	rootDir string
+	// baseDir is the directory which relative data file paths are relative to.
+	// This is the directory of the configuration file.
+	baseDir string
+	owners  []string
+}
+
+// Assign sets owners to errors and returns errors owned by the specified owners.
+// If the assigner is nil, errors are returned as is.
+func (a *ownerAssigner) Assign(errs []*domain.Error) []*domain.Error {
+	if a == nil {
+		return errs
+	}
+	list := make([]*domain.Error, 0, len(errs))
+	for _, e := range errs {
+		if e.DataFile != "" {
+			if p, ok := a.relPath(e.DataFile); ok {
+				e.Owners = a.codeOwners.Owners(p)
+			}
+		}
+		if a.match(e) {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// relPath converts a data file path to a slash separated relative path from the root directory.
+// If the data file isn't in the root directory, false is returned.
+func (a *ownerAssigner) relPath(dataFile string) (string, bool) {
+	if !filepath.IsAbs(dataFile) {
+		dataFile = filepath.Join(a.baseDir, dataFile)
+	}
+	p, err := filepath.Rel(a.rootDir, dataFile)
+	if err != nil || strings.HasPrefix(p, "..") {
+		return "", false
+	}
+	return filepath.ToSlash(p), true
+}
+
+// match returns true if the error is owned by any of the specified owners.
+// Owners are case insensitive like GitHub.
+func (a *ownerAssigner) match(e *domain.Error) bool {
+	if len(a.owners) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(a.owners, func(owner string) bool {
+		return slices.ContainsFunc(e.Owners, func(o string) bool {
+			return strings.EqualFold(o, owner)
+		})
+	})
+}
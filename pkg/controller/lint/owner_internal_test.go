@@ -0,0 +1,89 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/codeowners"
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+func Test_ownerAssigner_Assign(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	co, err := codeowners.Parse(strings.NewReader(`* @org/default
+/apps/ @org/apps @org/team-x
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := func() []*domain.Error {
+		return []*domain.Error{
+			{Name: "a", DataFile: "apps/foo.json"},
+			{Name: "e", DataFile: "config.json"},
+			{Name: "b", DataFile: "/workspace/README.md"},
+			{Name: "c", DataFile: "/tmp/foo.json"},
+			{Name: "d"},
+		}
+	}
+	data := []struct {
+		name     string
+		assigner *ownerAssigner
+		exp      []*domain.Error
+	}{
+		{
+			name: "disabled",
+			exp:  errs(),
+		},
+		{
+			name: "assign",
+			assigner: &ownerAssigner{
+				codeOwners: co,
+				rootDir:    "/workspace",
+				baseDir:    "/workspace",
+			},
+			exp: []*domain.Error{
+				{Name: "a", DataFile: "apps/foo.json", Owners: []string{"@org/apps", "@org/team-x"}},
+				{Name: "e", DataFile: "config.json", Owners: []string{"@org/default"}},
+				{Name: "b", DataFile: "/workspace/README.md", Owners: []string{"@org/default"}},
+				{Name: "c", DataFile: "/tmp/foo.json"},
+				{Name: "d"},
+			},
+		},
+		{
+			name: "configuration file in a subdirectory",
+			assigner: &ownerAssigner{
+				codeOwners: co,
+				rootDir:    "/workspace",
+				baseDir:    "/workspace/apps",
+			},
+			exp: []*domain.Error{
+				{Name: "a", DataFile: "apps/foo.json", Owners: []string{"@org/apps", "@org/team-x"}},
+				{Name: "e", DataFile: "config.json", Owners: []string{"@org/apps", "@org/team-x"}},
+				{Name: "b", DataFile: "/workspace/README.md", Owners: []string{"@org/default"}},
+				{Name: "c", DataFile: "/tmp/foo.json"},
+				{Name: "d"},
+			},
+		},
+		{
+			name: "filter",
+			assigner: &ownerAssigner{
+				codeOwners: co,
+				rootDir:    "/workspace",
+				baseDir:    "/workspace",
+				owners:     []string{"@org/Team-X"},
+			},
+			exp: []*domain.Error{
+				{Name: "a", DataFile: "apps/foo.json", Owners: []string{"@org/apps", "@org/team-x"}},
+			},
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(d.exp, d.assigner.Assign(errs())); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
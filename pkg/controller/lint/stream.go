@@ -0,0 +1,63 @@
+package lint
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/filefind"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/suzuki-shunsuke/go-error-with-exit-code/ecerror"
+)
+
+// lintStream lints targets and outputs errors as soon as lint files are evaluated.
+// Results aren't kept, and the summary is outputted at the end.
+func (c *Controller) lintStream(logger *slog.Logger, targets []*filefind.Target, streamer output.Streamer, param *ParamOutput, startTime time.Time) error {
+	summaryBuilder := output.NewSummaryBuilder()
+	failed := false
+	numExcluded := 0
+	// outputErr is an error of the streamer.
+	// It's distinguished from evaluation errors to return a different exit code.
+	var outputErr error
+	if err := c.linter.LintStream(targets, func(results []*domain.Result) error {
+		errs := param.owners.Assign(output.FormatResults(logger, results, param.ShownErrLevel))
+		summaryBuilder.Add(results, errs)
+		f, err := isFailed(errs, param.ErrLevel)
+		if err != nil {
+			return err
+		}
+		if f {
+			failed = true
+		}
+		if err := streamer.Stream(errs); err != nil {
+			outputErr = fmt.Errorf("output errors: %w", err)
+			return outputErr
+		}
+		if param.ShowExcluded {
+			excluded := param.owners.Assign(output.FormatExcludedResults(results))
+			numExcluded += len(excluded)
+			if err := streamer.Stream(excluded); err != nil {
+				outputErr = fmt.Errorf("output excluded errors: %w", err)
+				return outputErr
+			}
+		}
+		return nil
+	}); err != nil {
+		if outputErr != nil {
+			return ecerror.Wrap(outputErr, ExitCodeConfig)
+		}
+		return ecerror.Wrap(fmt.Errorf("lint targets: %w", err), ExitCodeEvaluation)
+	}
+	summary := summaryBuilder.Build(time.Since(startTime))
+	if param.OutputSuccess || summary.NumErrors > 0 || numExcluded > 0 {
+		if err := streamer.Output(&output.Output{
+			LintnetVersion: c.param.Version,
+			Env:            c.param.Env,
+			Summary:        summary,
+		}); err != nil {
+			return ecerror.Wrap(fmt.Errorf("output the summary: %w", err), ExitCodeConfig)
+		}
+	}
+	return resultError(failed, summary.NumEvaluationErrors, param.EvaluationErrorAsViolation)
+}
@@ -20,17 +20,22 @@ import (
 var testResultTemplateByte []byte
 
 type TestData struct {
-	Name         string                   `json:"name,omitempty"`
-	DataFile     string                   `json:"data_file,omitempty"`
-	FakeDataFile string                   `json:"fake_data_file,omitempty"`
-	DataFiles    []*DataFile              `json:"data_files,omitempty"`
-	Param        *domain.TopLevelArgument `json:"param,omitempty"`
-	Result       []any                    `json:"result,omitempty"`
+	Name         string `json:"name,omitempty"`
+	DataFile     string `json:"data_file,omitempty"`
+	FakeDataFile string `json:"fake_data_file,omitempty"`
+	// FileType is the file type of DataFile.
+	// FileType takes precedence over file_types in the configuration file.
+	FileType  *config.FileType         `json:"file_type,omitempty"`
+	DataFiles []*DataFile              `json:"data_files,omitempty"`
+	Param     *domain.TopLevelArgument `json:"param,omitempty"`
+	Result    []any                    `json:"result,omitempty"`
 }
 
 type DataFile struct {
 	Path     string `json:"path,omitempty"`
 	FakePath string `json:"fake_path,omitempty"`
+	// FileType takes precedence over file_types in the configuration file.
+	FileType *config.FileType `json:"file_type,omitempty"`
 }
 
 type dataFile DataFile
@@ -48,12 +53,23 @@ func (d *DataFile) UnmarshalJSON(b []byte) error {
 	}
 	d.Path = a.Path
 	d.FakePath = a.FakePath
+	d.FileType = a.FileType
 	return nil
 }
 
 type TestPair struct {
 	LintFilePath string
 	TestFilePath string
+	// FileTypes is file types of the configuration file.
+	// If the configuration file isn't used, FileTypes is nil.
+	FileTypes *FileTypes
+}
+
+// FileTypes is file types of a target and global file types in the configuration file.
+type FileTypes struct {
+	Target    config.FileTypes
+	Global    config.FileTypes
+	ConfigDir string
 }
 
 type FailedResult struct {
@@ -119,7 +135,7 @@ type ParamController struct {
 }
 
 func NewController(param *ParamController, fs afero.Fs, stdout io.Writer, importer jsonnet.Importer) *Controller {
-	dp := encoding.NewDataFileParser(fs)
+	dp := encoding.NewDataFileParser(fs, importer)
 	return &Controller{
 		param:          param,
 		fs:             fs,
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/config"
 	"github.com/lintnet/lintnet/pkg/controller/testcmd"
 )
 
@@ -34,6 +35,19 @@ func TestDataFile_UnmarshalJSON(t *testing.T) {
 				FakePath: ".github/workflows/foo.yaml",
 			},
 		},
+		{
+			name: "file type",
+			data: `{
+	"path": "foo.tmpl",
+	"file_type": "yaml"
+}`,
+			exp: &testcmd.DataFile{
+				Path: "foo.tmpl",
+				FileType: &config.FileType{
+					Type: "yaml",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -16,6 +16,7 @@ import (
 	"github.com/lintnet/lintnet/pkg/config"
 	"github.com/lintnet/lintnet/pkg/domain"
 	"github.com/lintnet/lintnet/pkg/filefilter"
+	"github.com/lintnet/lintnet/pkg/filefind"
 	"github.com/lintnet/lintnet/pkg/jsonnet"
 	"github.com/spf13/afero"
 	"github.com/suzuki-shunsuke/slog-error/slogerr"
@@ -41,6 +42,7 @@ func (c *Controller) Test(_ context.Context, logger *slog.Logger, param *ParamTe
 	if err != nil {
 		return err
 	}
+	pairs = uniquePairs(pairs)
 
 	testResultTemplate, err := template.New("_").Parse(string(testResultTemplateByte))
 	if err != nil {
@@ -90,12 +92,44 @@ func (c *Controller) listPairs(logger *slog.Logger, param *ParamTest) ([]*TestPa
 
 	cfgDir := filepath.Dir(rawCfg.FilePath)
 
-	lintFiles, err := c.fileFinder.FindLintFiles(logger, cfg, cfgDir)
-	if err != nil {
-		return nil, fmt.Errorf("find files: %w", err)
+	pairs := []*TestPair{}
+	for _, target := range cfg.Targets {
+		// Find lint files per target to apply file types of the target to test data files.
+		lintFiles, err := c.fileFinder.FindLintFiles(logger, &config.Config{
+			Targets:         []*config.Target{target},
+			IgnoredPatterns: cfg.IgnoredPatterns,
+		}, cfgDir)
+		if err != nil {
+			return nil, fmt.Errorf("find files: %w", err)
+		}
+		fileTypes := &FileTypes{
+			Target:    target.FileTypes,
+			Global:    cfg.FileTypes,
+			ConfigDir: cfgDir,
+		}
+		for _, pair := range c.filterLintFilesWithTest(logger, lintFiles) {
+			pair.FileTypes = fileTypes
+			pairs = append(pairs, pair)
+		}
 	}
+	return pairs, nil
+}
 
-	return c.filterLintFilesWithTest(logger, lintFiles), nil
+// uniquePairs removes pairs whose lint files are duplicated so that each lint file is tested once.
+// A lint file is duplicated if it's shared by multiple targets or given multiple times.
+// The first pair is kept, so file types of the first target are applied to test data files.
+func uniquePairs(pairs []*TestPair) []*TestPair {
+	lintFiles := make(map[string]struct{}, len(pairs))
+	ret := make([]*TestPair, 0, len(pairs))
+	for _, pair := range pairs {
+		p := filepath.Clean(pair.LintFilePath)
+		if _, ok := lintFiles[p]; ok {
+			continue
+		}
+		lintFiles[p] = struct{}{}
+		ret = append(ret, pair)
+	}
+	return ret
 }
 
 func getTestFilePath(lintFilePath string) string {
@@ -223,9 +257,9 @@ func (c *Controller) test(pair *TestPair, td *TestData) *FailedResult { //nolint
 }
 
 func (c *Controller) readDatafile(pair *TestPair, td *TestData) error {
-	p := &domain.Path{
-		Raw: td.DataFile,
-		Abs: filepath.Join(filepath.Dir(pair.TestFilePath), td.DataFile),
+	p, err := newDataFilePath(pair, td.DataFile, td.FileType)
+	if err != nil {
+		return err
 	}
 	data, err := c.dataFileParser.Parse(p)
 	if err != nil {
@@ -244,12 +278,34 @@ func (c *Controller) readDatafile(pair *TestPair, td *TestData) error {
 	return nil
 }
 
+// newDataFilePath returns a path of a test data file.
+// If fileType is set, fileType is used.
+// Otherwise, file types of the configuration file are applied.
+func newDataFilePath(pair *TestPair, dataFile string, fileType *config.FileType) (*domain.Path, error) {
+	p := &domain.Path{
+		Raw: dataFile,
+		Abs: filepath.Join(filepath.Dir(pair.TestFilePath), dataFile),
+	}
+	if fileType != nil {
+		p.FileType = fileType.Type
+		p.ParseOption = fileType.ParseOption()
+		return p, nil
+	}
+	if pair.FileTypes == nil {
+		return p, nil
+	}
+	if err := filefind.SetFileTypes([]*filefind.Target{{DataFiles: domain.Paths{p}}}, pair.FileTypes.Target, pair.FileTypes.Global, pair.FileTypes.ConfigDir); err != nil {
+		return nil, fmt.Errorf("set a file type from the configuration file: %w", err)
+	}
+	return p, nil
+}
+
 func (c *Controller) readDatafiles(pair *TestPair, td *TestData) error {
 	combinedData := make([]*domain.Data, len(td.DataFiles))
 	for i, dataFile := range td.DataFiles {
-		p := &domain.Path{
-			Raw: dataFile.Path,
-			Abs: filepath.Join(filepath.Dir(pair.TestFilePath), dataFile.Path),
+		p, err := newDataFilePath(pair, dataFile.Path, dataFile.FileType)
+		if err != nil {
+			return fmt.Errorf("set a file type: %w", slogerr.With(err, "data_file", dataFile.Path))
 		}
 		data, err := c.dataFileParser.Parse(p)
 		if err != nil {
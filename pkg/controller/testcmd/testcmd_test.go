@@ -3,6 +3,7 @@ package testcmd_test
 import (
 	"bytes"
 	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/google/go-jsonnet"
@@ -20,6 +21,8 @@ func TestController_Test(t *testing.T) { //nolint:funlen
 		contents map[string]string
 		dirs     []string
 		isErr    bool
+		// numFailures is the number of failed test cases in the output.
+		numFailures int
 	}{
 		{
 			name: "normal",
@@ -44,6 +47,44 @@ func TestController_Test(t *testing.T) { //nolint:funlen
 			},
 			contents: map[string]string{},
 		},
+		{
+			name: "file types",
+			paramC: &testcmd.ParamController{
+				Version: "0.3.0",
+			},
+			param: &testcmd.ParamTest{
+				RootDir: "/home/foo/.local/share/lintnet",
+				PWD:     "/home/foo/workspace",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":    "testdata/file_type/lintnet.jsonnet",
+				"hello.jsonnet":      "testdata/hello.jsonnet",
+				"hello_test.jsonnet": "testdata/file_type/hello_test.jsonnet",
+				"testdata/pass.conf": "testdata/pass.json",
+				"testdata/fail.tmpl": "testdata/fail.json",
+			},
+			contents: map[string]string{},
+		},
+		{
+			// A lint file shared by multiple targets is tested once.
+			name: "lint file shared by targets",
+			paramC: &testcmd.ParamController{
+				Version: "0.3.0",
+			},
+			param: &testcmd.ParamTest{
+				RootDir: "/home/foo/.local/share/lintnet",
+				PWD:     "/home/foo/workspace",
+			},
+			files: map[string]string{
+				"lintnet.jsonnet":    "testdata/shared/lintnet.jsonnet",
+				"hello.jsonnet":      "testdata/hello.jsonnet",
+				"hello_test.jsonnet": "testdata/shared/hello_test.jsonnet",
+				"testdata/fail.json": "testdata/fail.json",
+			},
+			contents:    map[string]string{},
+			isErr:       true,
+			numFailures: 1,
+		},
 	}
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
@@ -63,14 +104,15 @@ func TestController_Test(t *testing.T) { //nolint:funlen
 			ctrl := testcmd.NewController(d.paramC, fs, stdout, importer)
 			logger := slog.New(slog.DiscardHandler)
 			if err := ctrl.Test(t.Context(), logger, d.param); err != nil {
-				if d.isErr {
-					return
+				if !d.isErr {
+					t.Fatal(err)
 				}
-				t.Fatal(err)
-			}
-			if d.isErr {
+			} else if d.isErr {
 				t.Fatal("error must be returned")
 			}
+			if n := strings.Count(stdout.String(), "Test Name: "); n != d.numFailures {
+				t.Fatalf("the number of failed tests: got %d, wanted %d", n, d.numFailures)
+			}
 		})
 	}
 }
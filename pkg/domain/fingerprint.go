@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Fingerprint returns a stable identity of the error.
+// The fingerprint is computed from the target id, the lint file id without the module ref, the rule name, the data file path, and the normalized location.
+// Lines and columns are removed from the location so that the fingerprint doesn't change when lines are added above the error.
+// If the normalized location is empty, the message is used instead of the location.
+// The fingerprint doesn't depend on the version of modules, so it's stable even if modules are updated.
+func Fingerprint(e *Error) string {
+	loc := normalizeLocation(e.Location)
+	if loc == "" {
+		loc = e.Message
+	}
+	h := sha256.New()
+	for _, s := range []string{e.TargetID, trimModuleRef(e.LintFile), e.Name, e.DataFile, loc} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// positionKeys are fields of locations which depend on positions in files.
+var positionKeys = []string{"line", "column", "end_line", "end_column"} //nolint:gochecknoglobals
+
+// normalizeLocation removes positions from the location and encodes it as JSON, whose object keys are sorted.
+// Paths such as JSON pointers are kept.
+// If the location has only positions or the location is a number, which would be a line number, an empty string is returned.
+func normalizeLocation(location any) string {
+	switch loc := location.(type) {
+	case nil, float64, int:
+		return ""
+	case map[string]any:
+		m := make(map[string]any, len(loc))
+		for k, v := range loc {
+			if !slices.Contains(positionKeys, k) {
+				m[k] = v
+			}
+		}
+		if len(m) == 0 {
+			return ""
+		}
+		location = m
+	}
+	b, err := json.Marshal(location)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// trimModuleRef removes the commit hash and the tag from a module lint file id.
+// e.g. github_archive/github.com/<repo owner>/<repo name>/<commit hash>/<path>:<tag> => github_archive/github.com/<repo owner>/<repo name>/<path>
+// If the lint file isn't a module, the lint file is returned as is.
+func trimModuleRef(lintFile string) string {
+	if !strings.HasPrefix(lintFile, "github_archive/") {
+		return lintFile
+	}
+	elems := strings.Split(lintFile, "/")
+	if len(elems) < 6 { //nolint:mnd
+		return lintFile
+	}
+	p, _, _ := strings.Cut(strings.Join(elems[5:], "/"), ":")
+	return strings.Join(append(elems[:4], p), "/")
+}
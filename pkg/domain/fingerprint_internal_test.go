@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"testing"
+)
+
+func Test_trimModuleRef(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name     string
+		lintFile string
+		exp      string
+	}{
+		{
+			name:     "not module",
+			lintFile: "hello.jsonnet",
+			exp:      "hello.jsonnet",
+		},
+		{
+			name:     "module",
+			lintFile: "github_archive/github.com/lintnet-modules/ghalint/00571db321e413d45be457f39e48cd4237399bb7/workflow/main.jsonnet:v0.3.0",
+			exp:      "github_archive/github.com/lintnet-modules/ghalint/workflow/main.jsonnet",
+		},
+		{
+			name:     "module without tag",
+			lintFile: "github_archive/github.com/lintnet-modules/ghalint/00571db321e413d45be457f39e48cd4237399bb7/workflow/main.jsonnet",
+			exp:      "github_archive/github.com/lintnet-modules/ghalint/workflow/main.jsonnet",
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			if s := trimModuleRef(d.lintFile); s != d.exp {
+				t.Fatalf("got %s, wanted %s", s, d.exp)
+			}
+		})
+	}
+}
+
+func Test_normalizeLocation(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name     string
+		location any
+		exp      string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:     "line number",
+			location: float64(10),
+		},
+		{
+			name: "only positions",
+			location: map[string]any{
+				"line":       float64(10),
+				"column":     float64(2),
+				"end_line":   float64(12),
+				"end_column": float64(3),
+			},
+		},
+		{
+			name: "path",
+			location: map[string]any{
+				"line": float64(10),
+				"path": "/spec/containers/0/image",
+			},
+			exp: `{"path":"/spec/containers/0/image"}`,
+		},
+		{
+			name:     "string",
+			location: "$.spec.containers[0].image",
+			exp:      `"$.spec.containers[0].image"`,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			if s := normalizeLocation(d.location); s != d.exp {
+				t.Fatalf("got %s, wanted %s", s, d.exp)
+			}
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+	e := &Error{
+		Name:     "image must be pinned",
+		LintFile: "image.jsonnet",
+		DataFile: "deploy.yaml",
+		Message:  "nginx:latest",
+		Location: map[string]any{
+			"line": float64(10),
+			"path": "/spec/containers/0/image",
+		},
+	}
+	moved := *e
+	moved.Location = map[string]any{
+		"line": float64(11),
+		"path": "/spec/containers/0/image",
+	}
+	if Fingerprint(e) != Fingerprint(&moved) {
+		t.Fatal("the fingerprint must not change when the line changes")
+	}
+	other := *e
+	other.Location = map[string]any{
+		"line": float64(10),
+		"path": "/spec/containers/1/image",
+	}
+	if Fingerprint(e) == Fingerprint(&other) {
+		t.Fatal("the fingerprint must change when the path changes")
+	}
+	lineOnly := *e
+	lineOnly.Location = map[string]any{"line": float64(10)}
+	lineOnlyMoved := *e
+	lineOnlyMoved.Location = map[string]any{"line": float64(20)}
+	if Fingerprint(&lineOnly) != Fingerprint(&lineOnlyMoved) {
+		t.Fatal("the message must be used if the location has only positions")
+	}
+}
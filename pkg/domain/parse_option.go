@@ -0,0 +1,43 @@
+package domain
+
+// ParseOption is options to parse a data file.
+type ParseOption struct {
+	// CSV is options of csv and tsv.
+	CSV *CSVOption `json:"csv,omitempty"`
+	// YAML is options of yaml.
+	YAML *YAMLOption `json:"yaml,omitempty"`
+	// Jsonnet is options of jsonnet.
+	Jsonnet *JsonnetOption `json:"jsonnet,omitempty"`
+}
+
+// CSVOption is options to parse CSV and TSV.
+type CSVOption struct {
+	// Header enables the header mode.
+	// Rows whose number of fields is different from the header are excluded and reported in the metadata.
+	// In the header mode, the first row is treated as the header and each row is converted to an object keyed by the header.
+	Header bool `json:"header,omitempty"`
+	// Delimiter is a field delimiter. The default is a comma for CSV and a tab for TSV.
+	Delimiter string `json:"delimiter,omitempty"`
+	// Comment is a comment character. Lines beginning with the comment character are ignored.
+	Comment string `json:"comment,omitempty"`
+	// LazyQuotes allows quotes in unquoted fields and non-doubled quotes in quoted fields.
+	LazyQuotes bool `json:"lazy_quotes,omitempty"`
+	// Limit is the maximum number of rows excluding the header. Rows after the limit are ignored.
+	// If Limit is 0, all rows are read.
+	Limit int `json:"limit,omitempty"`
+}
+
+// YAMLOption is options to parse YAML.
+type YAMLOption struct {
+	// Metadata enables metadata such as positions, duplicate keys, anchors, and aliases.
+	// If Metadata is true, duplicate keys aren't treated as errors.
+	Metadata bool `json:"metadata,omitempty"`
+}
+
+// JsonnetOption is options to evaluate Jsonnet data files.
+type JsonnetOption struct {
+	// TLA is top level arguments. Values are passed as code.
+	TLA map[string]any `json:"tla,omitempty"`
+	// ExtVars is external variables. Values are passed as code.
+	ExtVars map[string]any `json:"ext_vars,omitempty"`
+}
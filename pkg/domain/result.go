@@ -17,54 +17,110 @@ type (
 		Location    any    `json:"location,omitempty"`
 		Custom      any    `json:"custom,omitempty"`
 		Excluded    bool   `json:"excluded,omitempty"`
+		// ExclusionReason is the reason why the result is excluded.
+		ExclusionReason string `json:"exclusion_reason,omitempty"`
+		// Fingerprint overrides the fingerprint computed by lintnet.
+		Fingerprint string `json:"fingerprint,omitempty"`
+		// DataFile is the primary data file of the error.
+		// This is used only in combine lint files because combine lint files lint multiple data files.
+		DataFile string             `json:"data_file,omitempty"`
+		Related  []*RelatedLocation `json:"related,omitempty"`
+	}
+
+	// RelatedLocation is a location related to an error.
+	// e.g. If a resource name is duplicated in a.yaml and b.yaml, the error is reported on a.yaml and b.yaml is a related location.
+	RelatedLocation struct {
+		DataFile string `json:"data_file,omitempty"`
+		Location any    `json:"location,omitempty"`
+		Message  string `json:"message,omitempty"`
 	}
 
 	Result struct {
-		TargetID  string           `json:"target_id,omitempty"`
-		LintFile  string           `json:"lint_file,omitempty"`
-		DataFile  string           `json:"data_file,omitempty"`
-		DataFiles []string         `json:"data_files,omitempty"`
-		RawResult []*JsonnetResult `json:"-"`
-		RawOutput string           `json:"-"`
-		Interface any              `json:"result,omitempty"`
-		Error     string           `json:"error,omitempty"`
+		TargetID string `json:"target_id,omitempty"`
+		// TargetIndex is the index of the target in the configuration file.
+		// It's set only if the target has no id.
+		TargetIndex *int             `json:"target_index,omitempty"`
+		LintFile    string           `json:"lint_file,omitempty"`
+		DataFile    string           `json:"data_file,omitempty"`
+		DataFiles   []string         `json:"data_files,omitempty"`
+		RawResult   []*JsonnetResult `json:"-"`
+		RawOutput   string           `json:"-"`
+		Interface   any              `json:"result,omitempty"`
+		Error       string           `json:"error,omitempty"`
+		// DataFileError is true if Error is an error of the data file such as a parse error.
+		// Otherwise, Error is an error of lint file evaluation.
+		DataFileError bool `json:"-"`
+		// Skipped is true if the data file is skipped.
+		// Errors of skipped data files are always shown regardless of the shown error level.
+		Skipped bool `json:"-"`
 	}
 )
 
 func (result *Result) FlatErrors() []*Error {
 	if result.Error != "" {
-		return []*Error{
-			{
-				LintFile: result.LintFile,
-				DataFile: result.DataFile,
-				// DataFilePaths: result.DataFiles,
-				TargetID: result.TargetID,
-				Message:  result.Error,
-			},
+		e := &Error{
+			LintFile: result.LintFile,
+			DataFile: result.DataFile,
+			// DataFilePaths: result.DataFiles,
+			TargetID:    result.TargetID,
+			TargetIndex: result.TargetIndex,
+			Message:     result.Error,
 		}
+		e.Fingerprint = Fingerprint(e)
+		return []*Error{e}
 	}
 	fes := make([]*Error, 0, len(result.RawResult))
 	for _, r := range result.RawResult {
 		if r.Excluded {
 			continue
 		}
-		fes = append(fes, &Error{
-			Name:        r.Name,
-			Level:       r.Level,
-			Message:     r.Message,
-			Description: r.Description,
-			LintFile:    result.LintFile,
-			DataFile:    result.DataFile,
-			Links:       r.Links,
-			// DataFilePaths: result.DataFiles,
-			TargetID: result.TargetID,
-			Location: r.Location,
-			Custom:   r.Custom,
-		})
+		fes = append(fes, result.newError(r))
+	}
+	return fes
+}
+
+// ExcludedErrors returns errors excluded by lint files.
+// Excluded errors are used to audit exclusions, and they never fail the lint.
+func (result *Result) ExcludedErrors() []*Error {
+	var fes []*Error
+	for _, r := range result.RawResult {
+		if !r.Excluded {
+			continue
+		}
+		e := result.newError(r)
+		e.Excluded = true
+		e.ExclusionReason = r.ExclusionReason
+		fes = append(fes, e)
 	}
 	return fes
 }
 
+func (result *Result) newError(r *JsonnetResult) *Error {
+	e := &Error{
+		Name:        r.Name,
+		Level:       r.Level,
+		Message:     r.Message,
+		Description: r.Description,
+		LintFile:    result.LintFile,
+		DataFile:    result.DataFile,
+		Links:       r.Links,
+		// DataFilePaths: result.DataFiles,
+		TargetID:    result.TargetID,
+		TargetIndex: result.TargetIndex,
+		Location:    r.Location,
+		Custom:      r.Custom,
+		Related:     r.Related,
+	}
+	if e.DataFile == "" {
+		e.DataFile = r.DataFile
+	}
+	e.Fingerprint = r.Fingerprint
+	if e.Fingerprint == "" {
+		e.Fingerprint = Fingerprint(e)
+	}
+	return e
+}
+
 type Error struct {
 	Name        string  `json:"name,omitempty"`
 	Description string  `json:"description,omitempty"`
@@ -75,8 +131,21 @@ type Error struct {
 	DataFile    string  `json:"data_file,omitempty"`
 	// DataFilePaths []string `json:"data_files,omitempty"`
 	TargetID string `json:"target_id,omitempty"`
-	Location any    `json:"location,omitempty"`
-	Custom   any    `json:"custom,omitempty"`
+	// TargetIndex is the index of the target in the configuration file.
+	// It's set only if the target has no id.
+	TargetIndex *int `json:"target_index,omitempty"`
+	Location    any  `json:"location,omitempty"`
+	Custom      any  `json:"custom,omitempty"`
+	// Related is locations related to the error.
+	Related []*RelatedLocation `json:"related,omitempty"`
+	// Fingerprint is a stable identity of the error.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// Excluded is true if the error is excluded by the lint file.
+	// Excluded errors are outputted only if -show-excluded is set.
+	Excluded        bool   `json:"excluded,omitempty"`
+	ExclusionReason string `json:"exclusion_reason,omitempty"`
+	// Owners are owners of the data file in CODEOWNERS.
+	Owners []string `json:"owners,omitempty"`
 }
 
 func (e *Error) Failed(errLevel errlevel.Level) (bool, error) {
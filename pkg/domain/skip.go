@@ -0,0 +1,27 @@
+package domain
+
+// SkipError is returned when a data file is skipped because it is too large or binary.
+// Skipped data files aren't linted and are reported as warnings.
+// The warnings are always shown so that data files aren't skipped silently.
+type SkipError struct {
+	Reason string
+}
+
+func (e *SkipError) Error() string {
+	return "the data file is skipped: " + e.Reason
+}
+
+// SkippedResult returns a warning result of a skipped data file.
+func SkippedResult(dataFile string, err *SkipError) *Result {
+	return &Result{
+		DataFile: dataFile,
+		Skipped:  true,
+		RawResult: []*JsonnetResult{
+			{
+				Name:    "data file is skipped",
+				Level:   "warn",
+				Message: err.Error(),
+			},
+		},
+	}
+}
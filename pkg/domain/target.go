@@ -13,6 +13,10 @@ func (ps Paths) Raw() []string {
 type Path struct {
 	Raw string `json:"raw,omitempty"`
 	Abs string `json:"abs,omitempty"`
+	// FileType overrides the file type judged by the file name.
+	FileType string `json:"file_type,omitempty"`
+	// ParseOption is options to parse the file.
+	ParseOption *ParseOption `json:"parse_option,omitempty"`
 }
 
 type Data struct {
@@ -20,6 +24,9 @@ type Data struct {
 	Value    any    `json:"value"`
 	FilePath string `json:"file_path"`
 	FileType string `json:"file_type"`
+	// Metadata is metadata of the data file such as positions of YAML.
+	// This is set only if it is enabled by parse options.
+	Metadata any    `json:"metadata,omitempty"`
 	JSON     []byte `json:"-"`
 }
 
@@ -2,22 +2,160 @@ package encoding
 
 import (
 	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/lintnet/lintnet/pkg/domain"
 )
 
 type csvUnmarshaler struct {
-	TSV bool
+	TSV    bool
+	Option *domain.CSVOption
+}
+
+func newCSVUnmarshaler(tsv bool, option *domain.CSVOption) (Unmarshaler, error) {
+	if option != nil {
+		if err := validateCSVRune("delimiter", option.Delimiter); err != nil {
+			return nil, err
+		}
+		if err := validateCSVRune("comment", option.Comment); err != nil {
+			return nil, err
+		}
+		if option.Limit < 0 {
+			return nil, errors.New("limit must not be negative")
+		}
+	}
+	return &csvUnmarshaler{
+		TSV:    tsv,
+		Option: option,
+	}, nil
+}
+
+func validateCSVRune(name, s string) error {
+	if s != "" && utf8.RuneCountInString(s) != 1 {
+		return fmt.Errorf("%s must be a single character: %s", name, s)
+	}
+	return nil
 }
 
 func (c *csvUnmarshaler) Unmarshal(b []byte) (any, error) {
+	value, _, err := c.UnmarshalWithMetadata(b)
+	return value, err
+}
+
+// UnmarshalWithMetadata parses CSV.
+// In the header mode, metadata is the following object.
+// Rows whose number of fields is different from the header are excluded from the value and reported as errors.
+//
+//	{
+//	  "errors": [
+//	    {"row": 1, "line": 2, "message": "wrong number of fields: expected 2, got 1"}
+//	  ]
+//	}
+//
+// Row numbers start from 1 and the header row isn't counted.
+// If the header mode is disabled, metadata is nil.
+func (c *csvUnmarshaler) UnmarshalWithMetadata(b []byte) (any, any, error) {
 	reader := csv.NewReader(strings.NewReader(string(b)))
 	if c.TSV {
 		reader.Comma = '	'
 	}
-	records, err := reader.ReadAll()
+	option := c.Option
+	if option == nil {
+		option = &domain.CSVOption{}
+	}
+	if option.Delimiter != "" {
+		reader.Comma, _ = utf8.DecodeRuneInString(option.Delimiter)
+	}
+	if option.Comment != "" {
+		reader.Comment, _ = utf8.DecodeRuneInString(option.Comment)
+	}
+	reader.LazyQuotes = option.LazyQuotes
+	if !option.Header && option.Limit == 0 {
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse a file as CSV: %w", err)
+		}
+		return records, nil, nil
+	}
+	if option.Header {
+		// The number of fields is checked by the header mode to report all mismatched rows.
+		reader.FieldsPerRecord = -1
+	}
+	records, lines, err := readCSV(reader, option)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse a file as CSV: %w", err)
+	}
+	if !option.Header {
+		return records, nil, nil
+	}
+	objects, rowErrors, err := csvToObjects(records, lines)
 	if err != nil {
-		return nil, fmt.Errorf("parse a file as CSV: %w", err)
+		return nil, nil, err
+	}
+	return objects, map[string]any{
+		"errors": rowErrors,
+	}, nil
+}
+
+// readCSV reads records until the limit and returns records and their line numbers.
+// If the header mode is enabled, the header isn't counted.
+func readCSV(reader *csv.Reader, option *domain.CSVOption) ([][]string, []int, error) {
+	limit := option.Limit
+	if limit > 0 && option.Header {
+		limit++
+	}
+	records := [][]string{}
+	lines := []int{}
+	for limit == 0 || len(records) < limit {
+		record, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, nil, err //nolint:wrapcheck
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+	return records, lines, nil
+}
+
+// csvToObjects converts rows to objects keyed by the header row.
+// Rows whose number of fields is different from the header are skipped and returned as errors with row numbers.
+// Row numbers start from 1 and the header row isn't counted.
+func csvToObjects(records [][]string, lines []int) ([]any, []any, error) {
+	rowErrors := []any{}
+	if len(records) == 0 {
+		return []any{}, rowErrors, nil
+	}
+	header := records[0]
+	seen := make(map[string]struct{}, len(header))
+	for _, name := range header {
+		if _, ok := seen[name]; ok {
+			return nil, nil, fmt.Errorf("parse a file as CSV: the header %s is duplicated", name)
+		}
+		seen[name] = struct{}{}
+	}
+	objects := make([]any, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if len(record) != len(header) {
+			rowErrors = append(rowErrors, map[string]any{
+				"row":     i + 1,
+				"line":    lines[i+1],
+				"message": fmt.Sprintf("wrong number of fields: expected %d, got %d", len(header), len(record)),
+			})
+			continue
+		}
+		obj := make(map[string]any, len(header))
+		for j, name := range header {
+			obj[name] = record[j]
+		}
+		objects = append(objects, obj)
 	}
-	return records, nil
+	return objects, rowErrors, nil
 }
@@ -0,0 +1,357 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// dockerfileUnmarshaler parses Dockerfile and returns the following object.
+//
+//	{
+//	  "directives": {"syntax": "docker/dockerfile:1"}, // parser directives
+//	  "instructions": [], // all instructions in order
+//	  "stages": [
+//	    {
+//	      "index": 0,
+//	      "name": "builder", // the lowercased stage name. This is empty if the stage has no name
+//	      "base": "golang:1.22", // the base image
+//	      "platform": "linux/amd64", // the value of the flag --platform
+//	      "start_line": 2,
+//	      "instructions": [], // instructions of the stage including FROM
+//	    },
+//	  ],
+//	}
+//
+// Each instruction is the following object.
+//
+//	{
+//	  "cmd": "RUN", // the uppercased instruction
+//	  "flags": ["--mount=type=cache,target=/root/.cache"],
+//	  "args": ["go build"],
+//	  "json": false, // true if the instruction is written in the exec form (JSON array)
+//	  "original": "RUN --mount=type=cache,target=/root/.cache go build",
+//	  "start_line": 3,
+//	  "end_line": 3,
+//	  "heredocs": [{"name": "EOF", "content": "echo hello\n", "expand": true, "chomp": false}], // this is set only if the instruction has heredocs
+//	}
+//
+// In the shell form, RUN, CMD, ENTRYPOINT, and ONBUILD have a single argument which is the rest of the instruction.
+// Arguments of other instructions are split by white spaces and quotes are removed.
+// Variables aren't expanded.
+type dockerfileUnmarshaler struct{}
+
+var (
+	dockerfileDirectivePattern = regexp.MustCompile(`^#\s*([a-zA-Z]+)\s*=\s*(.*?)\s*$`)              //nolint:gochecknoglobals
+	dockerfileHeredocPattern   = regexp.MustCompile(`^<<(-?)(["']?)([a-zA-Z_][a-zA-Z0-9_]*)(["']?)`) //nolint:gochecknoglobals
+)
+
+type dockerfileParser struct {
+	lines  []string
+	escape byte
+	idx    int
+}
+
+func (d *dockerfileUnmarshaler) Unmarshal(b []byte) (any, error) {
+	p := &dockerfileParser{
+		lines:  splitLines(string(b)),
+		escape: '\\',
+	}
+	directives := p.parseDirectives()
+	if escape, ok := directives["escape"]; ok {
+		s := escape.(string) //nolint:forcetypeassert
+		if s != "\\" && s != "`" {
+			return nil, fmt.Errorf("parse a file as Dockerfile: invalid escape directive: %s", s)
+		}
+		p.escape = s[0]
+	}
+	instructions := []any{}
+	stages := []any{}
+	var stage map[string]any
+	for {
+		inst := p.next()
+		if inst == nil {
+			break
+		}
+		instructions = append(instructions, inst)
+		if inst["cmd"] == "FROM" {
+			stage = newDockerfileStage(inst, len(stages))
+			stages = append(stages, stage)
+		}
+		if stage != nil {
+			stage["instructions"] = append(stage["instructions"].([]any), inst) //nolint:forcetypeassert
+		}
+	}
+	return map[string]any{
+		"directives":   directives,
+		"instructions": instructions,
+		"stages":       stages,
+	}, nil
+}
+
+// parseDirectives parses parser directives at the top of Dockerfile.
+func (p *dockerfileParser) parseDirectives() map[string]any {
+	directives := map[string]any{}
+	for ; p.idx < len(p.lines); p.idx++ {
+		m := dockerfileDirectivePattern.FindStringSubmatch(p.lines[p.idx])
+		if m == nil {
+			break
+		}
+		key := strings.ToLower(m[1])
+		if key != "syntax" && key != "escape" && key != "check" {
+			break
+		}
+		directives[key] = m[2]
+	}
+	return directives
+}
+
+// next parses the next instruction.
+// If there is no instruction, nil is returned.
+func (p *dockerfileParser) next() map[string]any {
+	// Skip empty lines and comments.
+	for ; p.idx < len(p.lines); p.idx++ {
+		line := strings.TrimSpace(p.lines[p.idx])
+		if line != "" && !strings.HasPrefix(line, "#") {
+			break
+		}
+	}
+	if p.idx >= len(p.lines) {
+		return nil
+	}
+	startLine := p.idx + 1
+	text := p.readLogicalLine()
+	cmd, rest, _ := strings.Cut(strings.TrimSpace(text), " ")
+	cmd = strings.ToUpper(strings.TrimSpace(cmd))
+	// A tab can also separate the instruction and arguments.
+	if c, r, ok := strings.Cut(cmd, "\t"); ok {
+		cmd = c
+		rest = r + " " + rest
+	}
+	inst := map[string]any{
+		"cmd": cmd,
+	}
+	var heredocs []any
+	if cmd == "RUN" || cmd == "COPY" || cmd == "ADD" {
+		heredocs = p.readHeredocs(rest)
+	}
+	inst["original"] = strings.Join(p.lines[startLine-1:p.idx], "\n")
+	inst["start_line"] = startLine
+	inst["end_line"] = p.idx
+	flags, rest := splitDockerfileFlags(strings.TrimSpace(rest))
+	inst["flags"] = flags
+	args, isJSON := p.parseArgs(cmd, rest)
+	inst["args"] = args
+	inst["json"] = isJSON
+	if heredocs != nil {
+		inst["heredocs"] = heredocs
+	}
+	return inst
+}
+
+// readLogicalLine reads a line and continuation lines.
+// Comments and empty lines in continuation lines are skipped.
+func (p *dockerfileParser) readLogicalLine() string {
+	var builder strings.Builder
+	for ; p.idx < len(p.lines); p.idx++ {
+		line := p.lines[p.idx]
+		trimmed := strings.TrimSpace(line)
+		if builder.Len() > 0 && (trimmed == "" || strings.HasPrefix(trimmed, "#")) {
+			continue
+		}
+		right := strings.TrimRight(line, " \t")
+		if !strings.HasSuffix(right, string(p.escape)) {
+			builder.WriteString(line)
+			p.idx++
+			return builder.String()
+		}
+		builder.WriteString(right[:len(right)-1])
+	}
+	return builder.String()
+}
+
+// readHeredocs reads heredocs following the instruction.
+// If a heredoc isn't closed, the instruction is treated as plain text and nil is returned.
+func (p *dockerfileParser) readHeredocs(rest string) []any {
+	matches := findHeredocs(rest)
+	if len(matches) == 0 {
+		return nil
+	}
+	idx := p.idx
+	heredocs := make([]any, 0, len(matches))
+	for _, m := range matches {
+		chomp := m[1] == "-"
+		name := m[3]
+		var content strings.Builder
+		closed := false
+		for ; p.idx < len(p.lines); p.idx++ {
+			line := p.lines[p.idx]
+			if chomp {
+				line = strings.TrimLeft(line, "\t")
+			}
+			if line == name {
+				closed = true
+				p.idx++
+				break
+			}
+			content.WriteString(line)
+			content.WriteString("\n")
+		}
+		if !closed {
+			p.idx = idx
+			return nil
+		}
+		heredocs = append(heredocs, map[string]any{
+			"name":    name,
+			"content": content.String(),
+			"expand":  m[2] == "",
+			"chomp":   chomp,
+		})
+	}
+	return heredocs
+}
+
+// findHeredocs finds heredoc markers such as <<EOF in the instruction.
+// A marker must be a separate shell word.
+// Markers in quotes, arithmetic expansions $(( )), and here-strings <<< are ignored.
+func findHeredocs(s string) [][]string {
+	var (
+		matches [][]string
+		quote   byte
+		arith   int
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case strings.HasPrefix(s[i:], "$(("):
+			arith++
+			i += 2
+		case arith > 0 && strings.HasPrefix(s[i:], "))"):
+			arith--
+			i++
+		case arith > 0:
+		case strings.HasPrefix(s[i:], "<<<"):
+			i += 2
+		case strings.HasPrefix(s[i:], "<<") && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			m := dockerfileHeredocPattern.FindStringSubmatch(s[i:])
+			if m == nil || m[2] != m[4] {
+				i++
+				continue
+			}
+			matches = append(matches, m)
+			i += len(m[0]) - 1
+		}
+	}
+	return matches
+}
+
+// splitDockerfileFlags splits leading flags such as --platform=linux/amd64 from arguments.
+func splitDockerfileFlags(s string) ([]any, string) {
+	flags := []any{}
+	for strings.HasPrefix(s, "--") {
+		flag, rest, _ := strings.Cut(s, " ")
+		if c, r, ok := strings.Cut(flag, "\t"); ok {
+			flag = c
+			rest = r + " " + rest
+		}
+		flags = append(flags, flag)
+		s = strings.TrimSpace(rest)
+	}
+	return flags, s
+}
+
+func (p *dockerfileParser) parseArgs(cmd, s string) ([]any, bool) {
+	switch cmd {
+	case "RUN", "CMD", "ENTRYPOINT", "SHELL", "COPY", "ADD", "VOLUME":
+		if strings.HasPrefix(s, "[") {
+			var arr []string
+			if err := json.Unmarshal([]byte(s), &arr); err == nil {
+				args := make([]any, len(arr))
+				for i, a := range arr {
+					args[i] = a
+				}
+				return args, true
+			}
+		}
+	}
+	switch cmd {
+	case "RUN", "CMD", "ENTRYPOINT", "ONBUILD":
+		if s == "" {
+			return []any{}, false
+		}
+		return []any{s}, false
+	}
+	return p.splitWords(s), false
+}
+
+// splitWords splits a string by white spaces and removes quotes.
+func (p *dockerfileParser) splitWords(s string) []any {
+	words := []any{}
+	var (
+		word  strings.Builder
+		quote byte
+		found bool
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == p.escape && quote != '\'' && i+1 < len(s):
+			i++
+			word.WriteByte(s[i])
+			found = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+				continue
+			}
+			word.WriteByte(c)
+		case c == '"' || c == '\'':
+			quote = c
+			found = true
+		case c == ' ' || c == '\t':
+			if found {
+				words = append(words, word.String())
+				word.Reset()
+				found = false
+			}
+		default:
+			word.WriteByte(c)
+			found = true
+		}
+	}
+	if found {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+func newDockerfileStage(from map[string]any, index int) map[string]any {
+	stage := map[string]any{
+		"index":        index,
+		"name":         "",
+		"base":         "",
+		"platform":     "",
+		"start_line":   from["start_line"],
+		"instructions": []any{},
+	}
+	args := from["args"].([]any) //nolint:forcetypeassert
+	if len(args) > 0 {
+		stage["base"] = args[0]
+	}
+	if len(args) > 2 && strings.EqualFold(args[1].(string), "as") { //nolint:forcetypeassert
+		stage["name"] = strings.ToLower(args[2].(string)) //nolint:forcetypeassert
+	}
+	for _, flag := range from["flags"].([]any) { //nolint:forcetypeassert
+		if platform, ok := strings.CutPrefix(flag.(string), "--platform="); ok { //nolint:forcetypeassert
+			stage["platform"] = platform
+		}
+	}
+	return stage
+}
@@ -0,0 +1,125 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// dotenvUnmarshaler parses dotenv files and returns a flat map of keys and values.
+//
+//	# comment
+//	export FOO=foo # `export ` is optional
+//	BAR="bar\nbaz" # escape sequences are expanded only in double quoted values
+//	ZOO='zoo'
+//
+// Quoted values can span multiple lines.
+// Variables such as ${FOO} aren't expanded.
+// Duplicate keys are treated as errors.
+type dotenvUnmarshaler struct{}
+
+func (d *dotenvUnmarshaler) Unmarshal(b []byte) (any, error) {
+	lines := splitLines(string(b))
+	values := map[string]any{}
+	keyLines := map[string]int{}
+	for i := 0; i < len(lines); i++ {
+		lineNum := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("parse a file as dotenv: line %d: = is missing", lineNum)
+		}
+		key := strings.TrimSpace(k)
+		if key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("parse a file as dotenv: line %d: invalid key %q", lineNum, key)
+		}
+		if l, ok := keyLines[key]; ok {
+			return nil, fmt.Errorf("parse a file as dotenv: line %d: key %s is duplicated with line %d", lineNum, key, l)
+		}
+		keyLines[key] = lineNum
+		value, n, err := parseDotenvValue(strings.TrimLeft(v, " \t"), lines[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("parse a file as dotenv: line %d: %w", lineNum, err)
+		}
+		i += n
+		values[key] = value
+	}
+	return values, nil
+}
+
+// parseDotenvValue parses a value.
+// If a quoted value spans multiple lines, following lines are consumed and the number of consumed lines is returned.
+func parseDotenvValue(s string, following []string) (string, int, error) {
+	if s == "" {
+		return "", 0, nil
+	}
+	quote := s[0]
+	if quote != '"' && quote != '\'' {
+		// Unquoted value. A comment must be preceded by white spaces.
+		if idx := strings.Index(s, " #"); idx >= 0 {
+			s = s[:idx]
+		}
+		if idx := strings.Index(s, "\t#"); idx >= 0 {
+			s = s[:idx]
+		}
+		return strings.TrimSpace(s), 0, nil
+	}
+	s = s[1:]
+	for n := 0; ; n++ {
+		if idx := findClosingQuote(s, quote); idx >= 0 {
+			rest := strings.TrimSpace(s[idx+1:])
+			if rest != "" && !strings.HasPrefix(rest, "#") {
+				return "", 0, fmt.Errorf("unexpected characters after the quoted value: %s", rest)
+			}
+			value := s[:idx]
+			if quote == '"' {
+				value = unescapeDotenv(value)
+			}
+			return value, n, nil
+		}
+		if n >= len(following) {
+			return "", 0, errors.New("the quoted value isn't closed")
+		}
+		s += "\n" + following[n]
+	}
+}
+
+func findClosingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
+
+var dotenvReplacer = strings.NewReplacer( //nolint:gochecknoglobals
+	`\n`, "\n",
+	`\r`, "\r",
+	`\t`, "\t",
+	`\"`, `"`,
+	`\\`, `\`,
+)
+
+func unescapeDotenv(s string) string {
+	return dotenvReplacer.Replace(s)
+}
+
+// splitLines splits a text into lines.
+// Carriage returns at the end of lines are removed.
+func splitLines(s string) []string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
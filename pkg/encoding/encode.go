@@ -1,31 +1,148 @@
 package encoding
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
+
+	gojsonnet "github.com/google/go-jsonnet"
+	"github.com/lintnet/lintnet/pkg/domain"
 )
 
 type Unmarshaler interface {
 	Unmarshal(b []byte) (any, error)
 }
 
+// NewUnmarshaler returns an unmarshaler and a file type judged by the file name.
 func NewUnmarshaler(fileName string) (Unmarshaler, string, error) {
-	ext := filepath.Ext(fileName)
-	switch ext {
+	fileType := FileType(fileName)
+	unmarshaler, err := NewUnmarshalerByFileType(fileType, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	return unmarshaler, fileType, nil
+}
+
+// NewUnmarshalerByFileType returns an unmarshaler of the file type.
+// option can be nil.
+// Imports of Jsonnet files are resolved from the file system.
+func NewUnmarshalerByFileType(fileType string, option *domain.ParseOption) (Unmarshaler, error) {
+	return newUnmarshaler(fileType, option, nil, "")
+}
+
+// newUnmarshaler returns an unmarshaler of the file type.
+// importer and filePath are used to resolve imports of Jsonnet files.
+func newUnmarshaler(fileType string, option *domain.ParseOption, importer gojsonnet.Importer, filePath string) (Unmarshaler, error) {
+	if option == nil {
+		option = &domain.ParseOption{}
+	}
+	switch fileType {
+	case "csv":
+		return newCSVUnmarshaler(false, option.CSV)
+	case "json":
+		return &jsonUnmarshaler{}, nil
+	case "jsonc":
+		return &jsoncUnmarshaler{}, nil
+	case "json5":
+		return &json5Unmarshaler{}, nil
+	case "toml":
+		return &tomlUnmarshaler{}, nil
+	case "tsv":
+		return newCSVUnmarshaler(true, option.CSV)
+	case "yaml":
+		if option.YAML != nil && option.YAML.Metadata {
+			return &yamlMetadataUnmarshaler{}, nil
+		}
+		return &yamlUnmarshaler{}, nil
+	case "hcl2":
+		return &hcl2Unmarshaler{}, nil
+	case "xml":
+		return &xmlUnmarshaler{}, nil
+	case "dotenv":
+		return &dotenvUnmarshaler{}, nil
+	case "ini":
+		return &iniUnmarshaler{}, nil
+	case "properties":
+		return &propertiesUnmarshaler{}, nil
+	case "dockerfile":
+		return &dockerfileUnmarshaler{}, nil
+	case "markdown":
+		return &markdownUnmarshaler{}, nil
+	case "jsonnet":
+		return newJsonnetUnmarshaler(option.Jsonnet, importer, filePath), nil
+	case "plain_text":
+		return &plainUnmarshaler{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported file type: %s", fileType)
+	}
+}
+
+// FileType judges the file type by the file name.
+// If the file type is unknown, "plain_text" is returned.
+func FileType(fileName string) string {
+	if isJSONC(fileName) {
+		return "jsonc"
+	}
+	if isDotenv(fileName) {
+		return "dotenv"
+	}
+	switch filepath.Base(fileName) {
+	case ".editorconfig", ".gitconfig", ".gitmodules":
+		return "ini"
+	case "Dockerfile", "Containerfile":
+		return "dockerfile"
+	}
+	switch filepath.Ext(fileName) {
 	case ".csv":
-		return &csvUnmarshaler{}, "csv", nil
+		return "csv"
 	case ".json":
-		return &jsonUnmarshaler{}, "json", nil
+		return "json"
+	case ".jsonc":
+		return "jsonc"
+	case ".json5":
+		return "json5"
 	case ".toml":
-		return &tomlUnmarshaler{}, "toml", nil
+		return "toml"
 	case ".tsv":
-		return &csvUnmarshaler{
-			TSV: true,
-		}, "tsv", nil
+		return "tsv"
 	case ".yml", ".yaml":
-		return &yamlUnmarshaler{}, "yaml", nil
+		return "yaml"
 	case ".hcl", ".tf":
-		return &hcl2Unmarshaler{}, "hcl2", nil
+		return "hcl2"
+	case ".xml", ".csproj", ".vbproj", ".fsproj", ".vcxproj", ".props", ".targets", ".nuspec", ".resx", ".xaml", ".xsd", ".xsl", ".xslt", ".svg", ".plist":
+		return "xml"
+	case ".ini":
+		return "ini"
+	case ".properties":
+		return "properties"
+	case ".Dockerfile":
+		return "dockerfile"
+	case ".md", ".mdx":
+		return "markdown"
 	default:
-		return &plainUnmarshaler{}, "plain_text", nil
+		return "plain_text"
+	}
+}
+
+// isJSONC returns true if the file is a well known JSON file allowing comments and trailing commas.
+func isJSONC(fileName string) bool {
+	base := filepath.Base(fileName)
+	switch base {
+	case "tsconfig.json", "jsconfig.json", "devcontainer.json", ".devcontainer.json", ".eslintrc.json", "deno.json", "biome.json", "turbo.json":
+		return true
+	}
+	if strings.HasPrefix(base, "tsconfig.") && strings.HasSuffix(base, ".json") {
+		return true
+	}
+	if filepath.Ext(base) != ".json" {
+		return false
 	}
+	// VS Code settings such as .vscode/settings.json and .vscode/extensions.json
+	return filepath.Base(filepath.Dir(fileName)) == ".vscode"
+}
+
+// isDotenv returns true if the file is a dotenv file such as .env, .env.local, and production.env.
+func isDotenv(fileName string) bool {
+	base := filepath.Base(fileName)
+	return base == ".env" || strings.HasPrefix(base, ".env.") || strings.HasSuffix(base, ".env")
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/domain"
 	"github.com/lintnet/lintnet/pkg/encoding"
 )
 
@@ -51,6 +52,375 @@ func TestNewUnmarshaler(t *testing.T) { //nolint:funlen
 			},
 			fileType: "json",
 		},
+		{
+			name:     "jsonc",
+			fileName: "hello.jsonc",
+			data: `{
+  // comment
+  "name": "hello", /* comment */
+  "tags": ["foo",],
+}
+`,
+			exp: map[string]any{
+				"name": "hello",
+				"tags": []any{"foo"},
+			},
+			fileType: "jsonc",
+		},
+		{
+			name:     "tsconfig.json is jsonc",
+			fileName: "tsconfig.json",
+			data: `{
+  "compilerOptions": {
+    "strict": true, // comment
+  },
+}
+`,
+			exp: map[string]any{
+				"compilerOptions": map[string]any{
+					"strict": true,
+				},
+			},
+			fileType: "jsonc",
+		},
+		{
+			name:     "vscode settings is jsonc",
+			fileName: ".vscode/settings.json",
+			data:     `{"editor.tabSize": 2,}`,
+			exp: map[string]any{
+				"editor.tabSize": float64(2),
+			},
+			fileType: "jsonc",
+		},
+		{
+			name:     "json5",
+			fileName: "renovate.json5",
+			data: `{
+  // comment
+  extends: ['config:recommended',],
+  prConcurrentLimit: 0x10,
+}
+`,
+			exp: map[string]any{
+				"extends":           []any{"config:recommended"},
+				"prConcurrentLimit": float64(16),
+			},
+			fileType: "json5",
+		},
+		{
+			name:     "dotenv",
+			fileName: ".env.local",
+			data: `# comment
+export FOO=foo # comment
+BAR="bar\nbaz"
+ZOO='zoo # not comment'
+MULTI="a
+b"
+EMPTY=
+`,
+			exp: map[string]any{
+				"FOO":   "foo",
+				"BAR":   "bar\nbaz",
+				"ZOO":   "zoo # not comment",
+				"MULTI": "a\nb",
+				"EMPTY": "",
+			},
+			fileType: "dotenv",
+		},
+		{
+			name:     "ini",
+			fileName: ".editorconfig",
+			data: `root = true
+
+; comment
+[*.go]
+indent_style = tab
+
+[remote "origin"]
+url = https://example.com
+`,
+			exp: map[string]any{
+				"": map[string]any{
+					"root": "true",
+				},
+				"*.go": map[string]any{
+					"indent_style": "tab",
+				},
+				`remote "origin"`: map[string]any{
+					"url": "https://example.com",
+				},
+			},
+			fileType: "ini",
+		},
+		{
+			name:     "gitconfig",
+			fileName: ".gitconfig",
+			data: `[user]
+	name = Foo Bar
+	email = foo@example.com
+[remote "origin"]
+	url = git@github.com:lintnet/lintnet.git
+	fetch = +refs/heads/*:refs/remotes/origin/*
+	fetch = +refs/pull/*/head:refs/remotes/origin/pr/*
+[core]
+	bare
+`,
+			exp: map[string]any{
+				"user": map[string]any{
+					"name":  "Foo Bar",
+					"email": "foo@example.com",
+				},
+				`remote "origin"`: map[string]any{
+					"url":   "git@github.com:lintnet/lintnet.git",
+					"fetch": "+refs/pull/*/head:refs/remotes/origin/pr/*",
+				},
+				"core": map[string]any{
+					"bare": "",
+				},
+			},
+			fileType: "ini",
+		},
+		{
+			name:     "properties",
+			fileName: "application.properties",
+			data: `# comment
+! comment
+server.port=8080
+spring.application.name : hello
+message Hello\u0021
+list = a, \
+  b
+key\=with\:sep = value
+`,
+			exp: map[string]any{
+				"server.port":             "8080",
+				"spring.application.name": "hello",
+				"message":                 "Hello!",
+				"list":                    "a, b",
+				"key=with:sep":            "value",
+			},
+			fileType: "properties",
+		},
+		{
+			name:     "dockerfile",
+			fileName: "Dockerfile",
+			data: `# syntax=docker/dockerfile:1
+ARG GO_VERSION=1.22
+FROM --platform=$BUILDPLATFORM golang:${GO_VERSION} AS Builder
+# comment
+RUN --mount=type=cache,target=/root/.cache \
+  go build \
+  -o /app
+COPY <<-EOF /etc/hello.txt
+	hello
+	EOF
+FROM gcr.io/distroless/static
+COPY --from=builder /app "/usr/local/bin/my app"
+ENTRYPOINT ["/usr/local/bin/my app"]
+`,
+			exp: map[string]any{
+				"directives": map[string]any{
+					"syntax": "docker/dockerfile:1",
+				},
+				"instructions": []any{
+					map[string]any{
+						"cmd":        "ARG",
+						"flags":      []any{},
+						"args":       []any{"GO_VERSION=1.22"},
+						"json":       false,
+						"original":   "ARG GO_VERSION=1.22",
+						"start_line": 2,
+						"end_line":   2,
+					},
+					map[string]any{
+						"cmd":        "FROM",
+						"flags":      []any{"--platform=$BUILDPLATFORM"},
+						"args":       []any{"golang:${GO_VERSION}", "AS", "Builder"},
+						"json":       false,
+						"original":   "FROM --platform=$BUILDPLATFORM golang:${GO_VERSION} AS Builder",
+						"start_line": 3,
+						"end_line":   3,
+					},
+					map[string]any{
+						"cmd":        "RUN",
+						"flags":      []any{"--mount=type=cache,target=/root/.cache"},
+						"args":       []any{"go build   -o /app"},
+						"json":       false,
+						"original":   "RUN --mount=type=cache,target=/root/.cache \\\n  go build \\\n  -o /app",
+						"start_line": 5,
+						"end_line":   7,
+					},
+					map[string]any{
+						"cmd":        "COPY",
+						"flags":      []any{},
+						"args":       []any{"<<-EOF", "/etc/hello.txt"},
+						"json":       false,
+						"original":   "COPY <<-EOF /etc/hello.txt\n\thello\n\tEOF",
+						"start_line": 8,
+						"end_line":   10,
+						"heredocs": []any{
+							map[string]any{
+								"name":    "EOF",
+								"content": "hello\n",
+								"expand":  true,
+								"chomp":   true,
+							},
+						},
+					},
+					map[string]any{
+						"cmd":        "FROM",
+						"flags":      []any{},
+						"args":       []any{"gcr.io/distroless/static"},
+						"json":       false,
+						"original":   "FROM gcr.io/distroless/static",
+						"start_line": 11,
+						"end_line":   11,
+					},
+					map[string]any{
+						"cmd":        "COPY",
+						"flags":      []any{"--from=builder"},
+						"args":       []any{"/app", "/usr/local/bin/my app"},
+						"json":       false,
+						"original":   `COPY --from=builder /app "/usr/local/bin/my app"`,
+						"start_line": 12,
+						"end_line":   12,
+					},
+					map[string]any{
+						"cmd":        "ENTRYPOINT",
+						"flags":      []any{},
+						"args":       []any{"/usr/local/bin/my app"},
+						"json":       true,
+						"original":   `ENTRYPOINT ["/usr/local/bin/my app"]`,
+						"start_line": 13,
+						"end_line":   13,
+					},
+				},
+				"stages": []any{
+					map[string]any{
+						"index":      0,
+						"name":       "builder",
+						"base":       "golang:${GO_VERSION}",
+						"platform":   "$BUILDPLATFORM",
+						"start_line": 3,
+						"instructions": []any{
+							map[string]any{
+								"cmd":        "FROM",
+								"flags":      []any{"--platform=$BUILDPLATFORM"},
+								"args":       []any{"golang:${GO_VERSION}", "AS", "Builder"},
+								"json":       false,
+								"original":   "FROM --platform=$BUILDPLATFORM golang:${GO_VERSION} AS Builder",
+								"start_line": 3,
+								"end_line":   3,
+							},
+							map[string]any{
+								"cmd":        "RUN",
+								"flags":      []any{"--mount=type=cache,target=/root/.cache"},
+								"args":       []any{"go build   -o /app"},
+								"json":       false,
+								"original":   "RUN --mount=type=cache,target=/root/.cache \\\n  go build \\\n  -o /app",
+								"start_line": 5,
+								"end_line":   7,
+							},
+							map[string]any{
+								"cmd":        "COPY",
+								"flags":      []any{},
+								"args":       []any{"<<-EOF", "/etc/hello.txt"},
+								"json":       false,
+								"original":   "COPY <<-EOF /etc/hello.txt\n\thello\n\tEOF",
+								"start_line": 8,
+								"end_line":   10,
+								"heredocs": []any{
+									map[string]any{
+										"name":    "EOF",
+										"content": "hello\n",
+										"expand":  true,
+										"chomp":   true,
+									},
+								},
+							},
+						},
+					},
+					map[string]any{
+						"index":      1,
+						"name":       "",
+						"base":       "gcr.io/distroless/static",
+						"platform":   "",
+						"start_line": 11,
+						"instructions": []any{
+							map[string]any{
+								"cmd":        "FROM",
+								"flags":      []any{},
+								"args":       []any{"gcr.io/distroless/static"},
+								"json":       false,
+								"original":   "FROM gcr.io/distroless/static",
+								"start_line": 11,
+								"end_line":   11,
+							},
+							map[string]any{
+								"cmd":        "COPY",
+								"flags":      []any{"--from=builder"},
+								"args":       []any{"/app", "/usr/local/bin/my app"},
+								"json":       false,
+								"original":   `COPY --from=builder /app "/usr/local/bin/my app"`,
+								"start_line": 12,
+								"end_line":   12,
+							},
+							map[string]any{
+								"cmd":        "ENTRYPOINT",
+								"flags":      []any{},
+								"args":       []any{"/usr/local/bin/my app"},
+								"json":       true,
+								"original":   `ENTRYPOINT ["/usr/local/bin/my app"]`,
+								"start_line": 13,
+								"end_line":   13,
+							},
+						},
+					},
+				},
+			},
+			fileType: "dockerfile",
+		},
+		{
+			name:     "markdown",
+			fileName: "README.md",
+			data:     "---\ntitle: Hello\ntags: [a]\n---\n\n# Hello `lintnet`\n\nSee [the guide](./guide.md \"Guide\") and <https://example.com>.\n\n## Install\n\n![logo](logo.png)\n\n```sh title=install.sh\nnpm i\n```\n\n    indented\n",
+			exp: map[string]any{
+				"front_matter": map[string]any{
+					"title": "Hello",
+					"tags":  []any{"a"},
+				},
+				"headings": []any{
+					map[string]any{"level": 1, "text": "Hello lintnet", "line": 6},
+					map[string]any{"level": 2, "text": "Install", "line": 10},
+				},
+				"links": []any{
+					map[string]any{"kind": "link", "destination": "./guide.md", "title": "Guide", "text": "the guide", "line": 8},
+					map[string]any{"kind": "autolink", "destination": "https://example.com", "title": "", "text": "https://example.com", "line": 8},
+					map[string]any{"kind": "image", "destination": "logo.png", "title": "", "text": "logo", "line": 12},
+				},
+				"code_blocks": []any{
+					map[string]any{"fenced": true, "language": "sh", "info": "sh title=install.sh", "content": "npm i\n", "line": 14},
+					map[string]any{"fenced": false, "language": "", "info": "", "content": "indented\n", "line": 18},
+				},
+			},
+			fileType: "markdown",
+		},
+		{
+			name:     "markdown toml front matter",
+			fileName: "index.mdx",
+			data:     "+++\ntitle = \"Hello\"\n+++\n# Hello\n",
+			exp: map[string]any{
+				"front_matter": map[string]any{
+					"title": "Hello",
+				},
+				"headings": []any{
+					map[string]any{"level": 1, "text": "Hello", "line": 4},
+				},
+				"links":       []any{},
+				"code_blocks": []any{},
+			},
+			fileType: "markdown",
+		},
 		{
 			name:     "plain",
 			fileName: "hello.txt",
@@ -79,6 +449,131 @@ func TestNewUnmarshaler(t *testing.T) { //nolint:funlen
 			},
 			fileType: "yaml",
 		},
+		{
+			name:     "xml",
+			fileName: "pom.xml",
+			data: `<?xml version="1.0" encoding="UTF-8"?>
+<!-- comment -->
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <modelVersion>4.0.0</modelVersion>
+  <dependencies>
+    <dependency scope="test">
+      <artifactId>junit</artifactId>
+    </dependency>
+  </dependencies>
+</project>
+`,
+			exp: map[string]any{
+				"name":       "project",
+				"namespace":  "http://maven.apache.org/POM/4.0.0",
+				"namespaces": map[string]any{"": "http://maven.apache.org/POM/4.0.0"},
+				"attributes": map[string]any{},
+				"text":       "",
+				"children": []any{
+					map[string]any{
+						"name":       "modelVersion",
+						"namespace":  "http://maven.apache.org/POM/4.0.0",
+						"attributes": map[string]any{},
+						"text":       "4.0.0",
+						"children":   []any{},
+					},
+					map[string]any{
+						"name":       "dependencies",
+						"namespace":  "http://maven.apache.org/POM/4.0.0",
+						"attributes": map[string]any{},
+						"text":       "",
+						"children": []any{
+							map[string]any{
+								"name":       "dependency",
+								"namespace":  "http://maven.apache.org/POM/4.0.0",
+								"attributes": map[string]any{"scope": "test"},
+								"text":       "",
+								"children": []any{
+									map[string]any{
+										"name":       "artifactId",
+										"namespace":  "http://maven.apache.org/POM/4.0.0",
+										"attributes": map[string]any{},
+										"text":       "junit",
+										"children":   []any{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			fileType: "xml",
+		},
+		{
+			name:     "xml namespace prefix",
+			fileName: "AndroidManifest.xml",
+			data: `<manifest xmlns:android="http://schemas.android.com/apk/res/android">
+  <application android:label="hello"/>
+</manifest>`,
+			exp: map[string]any{
+				"name":       "manifest",
+				"namespaces": map[string]any{"android": "http://schemas.android.com/apk/res/android"},
+				"attributes": map[string]any{},
+				"text":       "",
+				"children": []any{
+					map[string]any{
+						"name":       "application",
+						"attributes": map[string]any{"android:label": "hello"},
+						"text":       "",
+						"children":   []any{},
+					},
+				},
+			},
+			fileType: "xml",
+		},
+		{
+			name:     "xml mixed content",
+			fileName: "hello.csproj",
+			data:     `<p>Hello, <b>lintnet</b> <![CDATA[& world]]></p>`,
+			exp: map[string]any{
+				"name":       "p",
+				"attributes": map[string]any{},
+				"text":       "Hello,  & world",
+				"children": []any{
+					map[string]any{
+						"name":       "b",
+						"attributes": map[string]any{},
+						"text":       "lintnet",
+						"children":   []any{},
+					},
+				},
+				"content": []any{
+					"Hello,",
+					map[string]any{
+						"name":       "b",
+						"attributes": map[string]any{},
+						"text":       "lintnet",
+						"children":   []any{},
+					},
+					"& world",
+				},
+			},
+			fileType: "xml",
+		},
+		{
+			name:     "jsonnet is plain text by default",
+			fileName: "hello.jsonnet",
+			data:     `function(param) []`,
+			fileType: "plain_text",
+		},
+		{
+			name:     "libsonnet is plain text by default",
+			fileName: "lib.libsonnet",
+			data:     `{name: 'foo'}`,
+			fileType: "plain_text",
+		},
+		{
+			// .cfg files such as HAProxy configuration aren't INI, so .cfg isn't parsed as INI unless file_types is configured.
+			name:     "cfg is plain text by default",
+			fileName: "haproxy.cfg",
+			data:     "global\n    maxconn 100\n",
+			fileType: "plain_text",
+		},
 	}
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
@@ -112,3 +607,364 @@ func TestNewUnmarshaler(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestNewUnmarshaler_invalidXML(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "mismatched end element",
+			data: `<a><b></a></b>`,
+		},
+		{
+			name: "multiple root elements",
+			data: `<a/><b/>`,
+		},
+		{
+			name: "no root element",
+			data: `<!-- comment -->`,
+		},
+		{
+			name: "unclosed element",
+			data: `<a><b/>`,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			unmarshaler, _, err := encoding.NewUnmarshaler("hello.xml")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := unmarshaler.Unmarshal([]byte(d.data)); err == nil {
+				t.Fatal("error must be returned")
+			}
+		})
+	}
+}
+
+func TestNewUnmarshalerByFileType(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name     string
+		fileType string
+		option   *domain.ParseOption
+		data     string
+		exp      any
+		isErr    bool
+	}{
+		{
+			name:     "csv header",
+			fileType: "csv",
+			option: &domain.ParseOption{
+				CSV: &domain.CSVOption{
+					Header: true,
+				},
+			},
+			data: "name,age\nmike,20\n",
+			exp: []any{
+				map[string]any{"name": "mike", "age": "20"},
+			},
+		},
+		{
+			name:     "csv options",
+			fileType: "csv",
+			option: &domain.ParseOption{
+				CSV: &domain.CSVOption{
+					Header:     true,
+					Delimiter:  ";",
+					Comment:    "#",
+					LazyQuotes: true,
+					Limit:      1,
+				},
+			},
+			data: "name;age\n# comment\nm\"ike;20\ntom\n",
+			exp: []any{
+				map[string]any{"name": `m"ike`, "age": "20"},
+			},
+		},
+		{
+			name:     "csv limit without header",
+			fileType: "tsv",
+			option: &domain.ParseOption{
+				CSV: &domain.CSVOption{
+					Limit: 1,
+				},
+			},
+			data: "mike\t20\ntom\t30\n",
+			exp:  [][]string{{"mike", "20"}},
+		},
+		{
+			name:     "csv header mismatched columns",
+			fileType: "csv",
+			option: &domain.ParseOption{
+				CSV: &domain.CSVOption{
+					Header: true,
+				},
+			},
+			data: "name,age\nmike\ntom,30\nbob,40,foo\n",
+			exp: []any{
+				map[string]any{"name": "tom", "age": "30"},
+			},
+		},
+		{
+			name:     "csv invalid delimiter",
+			fileType: "csv",
+			option: &domain.ParseOption{
+				CSV: &domain.CSVOption{
+					Delimiter: ";;",
+				},
+			},
+			isErr: true,
+		},
+		{
+			name:     "force jsonc",
+			fileType: "jsonc",
+			data:     `{"name": "hello",}`,
+			exp: map[string]any{
+				"name": "hello",
+			},
+		},
+		{
+			name:     "json5 infinity",
+			fileType: "json5",
+			data:     `{n: Infinity}`,
+			isErr:    true,
+		},
+		{
+			name:     "dotenv duplicate key",
+			fileType: "dotenv",
+			data:     "FOO=foo\nFOO=bar\n",
+			isErr:    true,
+		},
+		{
+			name:     "dotenv unclosed quote",
+			fileType: "dotenv",
+			data:     `FOO="foo`,
+			isErr:    true,
+		},
+		{
+			name:     "ini setup.cfg",
+			fileType: "ini",
+			data: `[metadata]
+name: foo
+version = 1.0.0
+
+[options]
+install_requires =
+    requests>=2.0
+    click
+python_requires = >=3.9
+
+[options.entry_points]
+console_scripts =
+    foo = foo.cli:main
+`,
+			exp: map[string]any{
+				"metadata": map[string]any{
+					"name":    "foo",
+					"version": "1.0.0",
+				},
+				"options": map[string]any{
+					"install_requires": "requests>=2.0\nclick",
+					"python_requires":  ">=3.9",
+				},
+				"options.entry_points": map[string]any{
+					"console_scripts": "foo = foo.cli:main",
+				},
+			},
+		},
+		{
+			name:     "ini repeated key",
+			fileType: "ini",
+			data:     "[a]\nfoo = 1\n[b]\nfoo = 2\n[a]\nfoo = 3\nfoo = 4\n",
+			exp: map[string]any{
+				"a": map[string]any{"foo": "4"},
+				"b": map[string]any{"foo": "2"},
+			},
+		},
+		{
+			name:     "ini same key in different sections",
+			fileType: "ini",
+			data:     "[a]\nfoo = 1\n[b]\nfoo = 2\n",
+			exp: map[string]any{
+				"a": map[string]any{"foo": "1"},
+				"b": map[string]any{"foo": "2"},
+			},
+		},
+		{
+			name:     "properties duplicate key",
+			fileType: "properties",
+			data:     "foo=1\nfoo:2\n",
+			isErr:    true,
+		},
+		{
+			name:     "jsonnet",
+			fileType: "jsonnet",
+			data:     `{name: 'foo', count: 1 + 1}`,
+			exp: map[string]any{
+				"name":  "foo",
+				"count": 2.0,
+			},
+		},
+		{
+			name:     "dockerfile unclosed heredoc",
+			fileType: "dockerfile",
+			data:     "RUN <<EOF\n",
+			exp: map[string]any{
+				"directives": map[string]any{},
+				"instructions": []any{
+					map[string]any{
+						"cmd":        "RUN",
+						"flags":      []any{},
+						"args":       []any{"<<EOF"},
+						"json":       false,
+						"original":   "RUN <<EOF",
+						"start_line": 1,
+						"end_line":   1,
+					},
+				},
+				"stages": []any{},
+			},
+		},
+		{
+			name:     "dockerfile arithmetic expansion and here-string",
+			fileType: "dockerfile",
+			data:     "RUN echo $((1<<x)) && cat <<<word\n",
+			exp: map[string]any{
+				"directives": map[string]any{},
+				"instructions": []any{
+					map[string]any{
+						"cmd":        "RUN",
+						"flags":      []any{},
+						"args":       []any{"echo $((1<<x)) && cat <<<word"},
+						"json":       false,
+						"original":   "RUN echo $((1<<x)) && cat <<<word",
+						"start_line": 1,
+						"end_line":   1,
+					},
+				},
+				"stages": []any{},
+			},
+		},
+		{
+			name:     "dockerfile escape directive",
+			fileType: "dockerfile",
+			data:     "# escape=`\nFROM windows\nRUN dir `\n  C:\\\n",
+			exp: map[string]any{
+				"directives": map[string]any{
+					"escape": "`",
+				},
+				"instructions": []any{
+					map[string]any{
+						"cmd":        "FROM",
+						"flags":      []any{},
+						"args":       []any{"windows"},
+						"json":       false,
+						"original":   "FROM windows",
+						"start_line": 2,
+						"end_line":   2,
+					},
+					map[string]any{
+						"cmd":        "RUN",
+						"flags":      []any{},
+						"args":       []any{"dir   C:\\"},
+						"json":       false,
+						"original":   "RUN dir `\n  C:\\",
+						"start_line": 3,
+						"end_line":   4,
+					},
+				},
+				"stages": []any{
+					map[string]any{
+						"index":      0,
+						"name":       "",
+						"base":       "windows",
+						"platform":   "",
+						"start_line": 2,
+						"instructions": []any{
+							map[string]any{
+								"cmd":        "FROM",
+								"flags":      []any{},
+								"args":       []any{"windows"},
+								"json":       false,
+								"original":   "FROM windows",
+								"start_line": 2,
+								"end_line":   2,
+							},
+							map[string]any{
+								"cmd":        "RUN",
+								"flags":      []any{},
+								"args":       []any{"dir   C:\\"},
+								"json":       false,
+								"original":   "RUN dir `\n  C:\\",
+								"start_line": 3,
+								"end_line":   4,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			// If front matter isn't closed, the whole file is parsed as the body.
+			name:     "markdown unclosed front matter",
+			fileType: "markdown",
+			data:     "---\ntitle: hello\n# Hello\n",
+			exp: map[string]any{
+				"front_matter": nil,
+				"headings": []any{
+					map[string]any{"level": 1, "text": "Hello", "line": 3},
+				},
+				"links":       []any{},
+				"code_blocks": []any{},
+			},
+		},
+		{
+			// If front matter is malformed, only the body is parsed.
+			name:     "markdown malformed front matter",
+			fileType: "markdown",
+			data:     "---\ntitle: [\n---\n# Hello\n",
+			exp: map[string]any{
+				"front_matter": nil,
+				"headings": []any{
+					map[string]any{"level": 1, "text": "Hello", "line": 4},
+				},
+				"links":       []any{},
+				"code_blocks": []any{},
+			},
+		},
+		{
+			name:     "unknown file type",
+			fileType: "foo",
+			isErr:    true,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			unmarshaler, err := encoding.NewUnmarshalerByFileType(d.fileType, d.option)
+			if err != nil {
+				if d.isErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			v, err := unmarshaler.Unmarshal([]byte(d.data))
+			if err != nil {
+				if d.isErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			if d.isErr {
+				t.Fatal("error must be returned")
+			}
+			if diff := cmp.Diff(d.exp, v); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
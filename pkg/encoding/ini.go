@@ -0,0 +1,155 @@
+package encoding
+
+import (
+	"fmt"
+	"strings"
+)
+
+// iniUnmarshaler parses INI files and returns a map of sections.
+// Each section is a map of keys and values.
+// Keys before the first section belong to the section "".
+// The section "" is omitted if it has no key.
+//
+//	root = true
+//
+//	[*.go]
+//	indent_style = tab
+//
+// is converted to
+//
+//	{"": {"root": "true"}, "*.go": {"indent_style": "tab"}}
+//
+// Lines starting with `#` or `;` are comments.
+// A key and a value are separated by the first `=` or `:`.
+// A key without a separator such as `bare = ` and `bare` has an empty string value.
+// A line indented deeper than the previous key is a continuation of the value, and lines are joined with newlines like Python's configparser.
+// If a section appears multiple times, keys are merged.
+// If a key appears multiple times in a section like `fetch` in git config, the last value is used and the key is reported in the metadata.
+// The metadata is the following object.
+//
+//	{
+//	  "duplicate_keys": [
+//	    {"section": "remote \"origin\"", "key": "fetch", "values": ["a", "b"], "lines": [3, 4]}
+//	  ]
+//	}
+type iniUnmarshaler struct{}
+
+// iniKey is a key in a section.
+// values and lines have all values and line numbers of the key in order.
+type iniKey struct {
+	section string
+	key     string
+	values  []string
+	lines   []int
+}
+
+func (d *iniUnmarshaler) Unmarshal(b []byte) (any, error) {
+	value, _, err := d.UnmarshalWithMetadata(b)
+	return value, err
+}
+
+// UnmarshalWithMetadata parses INI.
+// The metadata has keys which appear multiple times in a section.
+func (d *iniUnmarshaler) UnmarshalWithMetadata(b []byte) (any, any, error) { //nolint:cyclop
+	section := ""
+	sections := map[string]map[string]*iniKey{
+		section: {},
+	}
+	// keys is keys in order of first appearance.
+	keys := []*iniKey{}
+	// key is the last key in the current section, and keyIndent is the indentation of the key.
+	// They are used to join continuation lines.
+	var key *iniKey
+	keyIndent := 0
+	for i, rawLine := range splitLines(string(b)) {
+		lineNum := i + 1
+		line := strings.TrimSpace(rawLine)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		indent := len(rawLine) - len(strings.TrimLeft(rawLine, " \t"))
+		if key != nil && indent > keyIndent {
+			key.appendLine(line)
+			continue
+		}
+		key = nil
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, nil, fmt.Errorf("parse a file as INI: line %d: the section isn't closed", lineNum)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[section]; !ok {
+				sections[section] = map[string]*iniKey{}
+			}
+			continue
+		}
+		k, v := cutINIKey(line)
+		if k == "" {
+			return nil, nil, fmt.Errorf("parse a file as INI: line %d: the key is empty", lineNum)
+		}
+		key = sections[section][k]
+		if key == nil {
+			key = &iniKey{
+				section: section,
+				key:     k,
+			}
+			sections[section][k] = key
+			keys = append(keys, key)
+		}
+		key.values = append(key.values, v)
+		key.lines = append(key.lines, lineNum)
+		keyIndent = indent
+	}
+	value := make(map[string]any, len(sections))
+	for name, s := range sections {
+		if name == "" && len(s) == 0 {
+			continue
+		}
+		m := make(map[string]any, len(s))
+		for k, key := range s {
+			m[k] = key.values[len(key.values)-1]
+		}
+		value[name] = m
+	}
+	duplicateKeys := []any{}
+	for _, key := range keys {
+		if len(key.values) < 2 { //nolint:mnd
+			continue
+		}
+		values := make([]any, len(key.values))
+		lines := make([]any, len(key.lines))
+		for i, v := range key.values {
+			values[i] = v
+			lines[i] = key.lines[i]
+		}
+		duplicateKeys = append(duplicateKeys, map[string]any{
+			"section": key.section,
+			"key":     key.key,
+			"values":  values,
+			"lines":   lines,
+		})
+	}
+	return value, map[string]any{
+		"duplicate_keys": duplicateKeys,
+	}, nil
+}
+
+// cutINIKey splits a line into a key and a value by the first `=` or `:`.
+func cutINIKey(line string) (string, string) {
+	idx := strings.IndexAny(line, "=:")
+	if idx == -1 {
+		return line, ""
+	}
+	return strings.TrimSpace(line[:idx]), strings.TrimSpace(line[idx+1:])
+}
+
+// appendLine appends a continuation line to the last value of the key.
+// If the value is empty, the line becomes the value.
+func (k *iniKey) appendLine(line string) {
+	last := len(k.values) - 1
+	if k.values[last] == "" {
+		k.values[last] = line
+		return
+	}
+	k.values[last] += "\n" + line
+}
@@ -0,0 +1,47 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/titanous/json5"
+)
+
+// json5Unmarshaler parses JSON5.
+// The value is same as the value of plain JSON.
+type json5Unmarshaler struct{}
+
+func (d *json5Unmarshaler) Unmarshal(b []byte) (any, error) {
+	var dest any
+	if err := json5.Unmarshal(b, &dest); err != nil {
+		return nil, fmt.Errorf("parse a file as JSON5: %w", err)
+	}
+	// Infinity and NaN are valid in JSON5 but they can't be passed to lint files as JSON.
+	if err := validateJSON5Number(dest); err != nil {
+		return nil, fmt.Errorf("parse a file as JSON5: %w", err)
+	}
+	return dest, nil
+}
+
+func validateJSON5Number(v any) error {
+	switch a := v.(type) {
+	case float64:
+		if math.IsInf(a, 0) || math.IsNaN(a) {
+			return errors.New("infinity and NaN aren't supported")
+		}
+	case []any:
+		for _, e := range a {
+			if err := validateJSON5Number(e); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for _, e := range a {
+			if err := validateJSON5Number(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
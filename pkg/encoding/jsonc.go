@@ -0,0 +1,25 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tailscale/hujson"
+)
+
+// jsoncUnmarshaler parses JSON with comments and trailing commas (JSONC).
+// The value is same as the value of plain JSON.
+type jsoncUnmarshaler struct{}
+
+func (d *jsoncUnmarshaler) Unmarshal(b []byte) (any, error) {
+	// Standardize modifies the given slice, so copy it to keep the original text.
+	s, err := hujson.Standardize(append([]byte(nil), b...))
+	if err != nil {
+		return nil, fmt.Errorf("parse a file as JSONC: %w", err)
+	}
+	var dest any
+	if err := json.Unmarshal(s, &dest); err != nil {
+		return nil, fmt.Errorf("parse a file as JSONC: %w", err)
+	}
+	return dest, nil
+}
@@ -0,0 +1,62 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gojsonnet "github.com/google/go-jsonnet"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/jsonnet"
+)
+
+// jsonnetUnmarshaler evaluates a Jsonnet file and returns the manifested JSON value.
+// importer and filePath are used to resolve imports.
+type jsonnetUnmarshaler struct {
+	option   *domain.JsonnetOption
+	importer gojsonnet.Importer
+	filePath string
+}
+
+// newJsonnetUnmarshaler returns a jsonnetUnmarshaler.
+// If importer is nil, imports are resolved from the file system.
+func newJsonnetUnmarshaler(option *domain.JsonnetOption, importer gojsonnet.Importer, filePath string) *jsonnetUnmarshaler {
+	if importer == nil {
+		importer = &gojsonnet.FileImporter{}
+	}
+	return &jsonnetUnmarshaler{
+		option:   option,
+		importer: importer,
+		filePath: filePath,
+	}
+}
+
+func (j *jsonnetUnmarshaler) Unmarshal(b []byte) (any, error) {
+	vm := jsonnet.MakeVM()
+	jsonnet.SetNativeFunctions(vm)
+	vm.Importer(j.importer)
+	if j.option != nil {
+		for k, v := range j.option.TLA {
+			code, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("marshal a top level argument as JSON: %w", err)
+			}
+			vm.TLACode(k, string(code))
+		}
+		for k, v := range j.option.ExtVars {
+			code, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("marshal an external variable as JSON: %w", err)
+			}
+			vm.ExtCode(k, string(code))
+		}
+	}
+	result, err := vm.EvaluateAnonymousSnippet(j.filePath, string(b))
+	if err != nil {
+		return nil, fmt.Errorf("evaluate a file as Jsonnet: %w", err)
+	}
+	var dest any
+	if err := json.Unmarshal([]byte(result), &dest); err != nil {
+		return nil, fmt.Errorf("unmarshal the result of Jsonnet as JSON: %w", err)
+	}
+	return dest, nil
+}
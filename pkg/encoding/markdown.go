@@ -0,0 +1,247 @@
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+	"github.com/suzuki-shunsuke/go-convmap/convmap"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+	"gopkg.in/yaml.v3"
+)
+
+// markdownUnmarshaler parses Markdown and returns the following object.
+//
+//	{
+//	  "front_matter": {"title": "hello"}, // YAML (---) or TOML (+++) front matter. This is null if the file has no front matter
+//	  "headings": [{"level": 1, "text": "Hello", "line": 5}],
+//	  "links": [{"kind": "link", "destination": "./foo.md", "title": "", "text": "foo", "line": 7}], // kind is link, image, or autolink
+//	  "code_blocks": [{"fenced": true, "language": "go", "info": "go title=main.go", "content": "package main\n", "line": 9}],
+//	}
+//
+// Line numbers start from 1.
+// The line of a fenced code block is the line of the opening fence.
+//
+// If front matter is malformed, front_matter is null and only the body is parsed so that the body can still be linted.
+// The error is returned as the metadata.
+//
+//	{"front_matter_error": "front matter isn't closed: ---"} // front_matter_error is null if front matter is valid
+type markdownUnmarshaler struct{}
+
+func (m *markdownUnmarshaler) Unmarshal(b []byte) (any, error) {
+	value, _, err := m.UnmarshalWithMetadata(b)
+	return value, err
+}
+
+// UnmarshalWithMetadata parses Markdown.
+// The metadata has the error of front matter.
+func (m *markdownUnmarshaler) UnmarshalWithMetadata(b []byte) (any, any, error) {
+	var frontMatterErr any
+	frontMatter, body, err := parseFrontMatter(b)
+	if err != nil {
+		frontMatterErr = err.Error()
+	}
+	doc := goldmark.DefaultParser().Parse(text.NewReader(body))
+	w := &markdownWalker{
+		source:     body,
+		lineStarts: lineStarts(body),
+		headings:   []any{},
+		links:      []any{},
+		codeBlocks: []any{},
+	}
+	if err := ast.Walk(doc, w.walk); err != nil {
+		return nil, nil, fmt.Errorf("parse a file as Markdown: %w", err)
+	}
+	return map[string]any{
+		"front_matter": frontMatter,
+		"headings":     w.headings,
+		"links":        w.links,
+		"code_blocks":  w.codeBlocks,
+	}, map[string]any{
+		"front_matter_error": frontMatterErr,
+	}, nil
+}
+
+// parseFrontMatter parses front matter and returns the body.
+// Lines of front matter are replaced with empty lines in the body to keep line numbers.
+// Even if front matter is malformed, the body is returned with the error.
+// If front matter isn't closed, the whole file is the body.
+func parseFrontMatter(b []byte) (any, []byte, error) {
+	lines := bytes.SplitAfter(b, []byte("\n"))
+	if len(lines) == 0 {
+		return nil, b, nil
+	}
+	delimiter := string(bytes.TrimRight(lines[0], "\r\n"))
+	if delimiter != "---" && delimiter != "+++" {
+		return nil, b, nil
+	}
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		line := string(bytes.TrimRight(lines[i], "\r\n"))
+		if line == delimiter || (delimiter == "---" && line == "...") {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return nil, b, fmt.Errorf("front matter isn't closed: %s", delimiter)
+	}
+	body := make([]byte, 0, len(b))
+	for i, line := range lines {
+		if i <= end {
+			body = append(body, '\n')
+			continue
+		}
+		body = append(body, line...)
+	}
+	content := bytes.Join(lines[1:end], nil)
+	var frontMatter any
+	if delimiter == "---" {
+		if err := yaml.Unmarshal(content, &frontMatter); err != nil {
+			return nil, body, fmt.Errorf("parse front matter as YAML: %w", err)
+		}
+		v, err := convmap.Convert(frontMatter, nil)
+		if err != nil {
+			return nil, body, fmt.Errorf("convert map keys to string: %w", err)
+		}
+		frontMatter = v
+	} else if err := toml.Unmarshal(content, &frontMatter); err != nil {
+		return nil, body, fmt.Errorf("parse front matter as TOML: %w", err)
+	}
+	return frontMatter, body, nil
+}
+
+func lineStarts(b []byte) []int {
+	starts := []int{0}
+	for i, c := range b {
+		if c == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
+
+type markdownWalker struct {
+	source     []byte
+	lineStarts []int
+	headings   []any
+	links      []any
+	codeBlocks []any
+}
+
+// line returns the line number of the offset.
+func (w *markdownWalker) line(offset int) int {
+	return sort.Search(len(w.lineStarts), func(i int) bool {
+		return w.lineStarts[i] > offset
+	})
+}
+
+// nodeLine returns the line number of the node.
+// If the position of the node is unknown, the line of the nearest ancestor is returned.
+func (w *markdownWalker) nodeLine(node ast.Node) int {
+	for n := node; n != nil; n = n.Parent() {
+		if pos := n.Pos(); pos >= 0 {
+			return w.line(pos)
+		}
+		if n.Type() == ast.TypeBlock && n.Lines().Len() > 0 {
+			return w.line(n.Lines().At(0).Start)
+		}
+	}
+	return 0
+}
+
+func (w *markdownWalker) walk(node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	switch n := node.(type) {
+	case *ast.Heading:
+		w.headings = append(w.headings, map[string]any{
+			"level": n.Level,
+			"text":  w.text(n),
+			"line":  w.nodeLine(n),
+		})
+	case *ast.Link:
+		w.links = append(w.links, map[string]any{
+			"kind":        "link",
+			"destination": string(n.Destination),
+			"title":       string(n.Title),
+			"text":        w.text(n),
+			"line":        w.nodeLine(n),
+		})
+	case *ast.Image:
+		w.links = append(w.links, map[string]any{
+			"kind":        "image",
+			"destination": string(n.Destination),
+			"title":       string(n.Title),
+			"text":        w.text(n),
+			"line":        w.nodeLine(n),
+		})
+	case *ast.AutoLink:
+		w.links = append(w.links, map[string]any{
+			"kind":        "autolink",
+			"destination": string(n.URL(w.source)),
+			"title":       "",
+			"text":        string(n.Label(w.source)),
+			"line":        w.nodeLine(n),
+		})
+	case *ast.FencedCodeBlock:
+		info := ""
+		if n.Info != nil {
+			info = string(n.Info.Segment.Value(w.source))
+		}
+		w.codeBlocks = append(w.codeBlocks, map[string]any{
+			"fenced":   true,
+			"language": string(n.Language(w.source)),
+			"info":     info,
+			"content":  w.lines(n),
+			"line":     w.nodeLine(n),
+		})
+	case *ast.CodeBlock:
+		w.codeBlocks = append(w.codeBlocks, map[string]any{
+			"fenced":   false,
+			"language": "",
+			"info":     "",
+			"content":  w.lines(n),
+			"line":     w.nodeLine(n),
+		})
+	}
+	return ast.WalkContinue, nil
+}
+
+// lines returns the content of the block.
+func (w *markdownWalker) lines(node ast.Node) string {
+	var buf bytes.Buffer
+	lines := node.Lines()
+	for i := range lines.Len() {
+		seg := lines.At(i)
+		buf.Write(seg.Value(w.source))
+	}
+	return buf.String()
+}
+
+// text returns the plain text of the inline children.
+func (w *markdownWalker) text(node ast.Node) string {
+	var buf bytes.Buffer
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch t := n.(type) {
+		case *ast.Text:
+			buf.Write(t.Segment.Value(w.source))
+			if t.SoftLineBreak() || t.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(t.Value)
+		case *ast.AutoLink:
+			buf.Write(t.Label(w.source))
+		}
+		return ast.WalkContinue, nil
+	})
+	return buf.String()
+}
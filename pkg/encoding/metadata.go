@@ -0,0 +1,6 @@
+package encoding
+
+// metadataUnmarshaler is an Unmarshaler returning metadata of a data file in addition to the value.
+type metadataUnmarshaler interface {
+	UnmarshalWithMetadata(b []byte) (any, any, error)
+}
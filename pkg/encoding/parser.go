@@ -3,31 +3,54 @@ package encoding
 import (
 	"fmt"
 
+	gojsonnet "github.com/google/go-jsonnet"
 	"github.com/lintnet/lintnet/pkg/domain"
 	"github.com/spf13/afero"
 	"github.com/suzuki-shunsuke/slog-error/slogerr"
 )
 
 type DataFileParser struct {
-	fs afero.Fs
+	fs       afero.Fs
+	importer gojsonnet.Importer
+	maxSize  int64
 }
 
-func NewDataFileParser(fs afero.Fs) *DataFileParser {
+func NewDataFileParser(fs afero.Fs, importer gojsonnet.Importer) *DataFileParser {
 	return &DataFileParser{
-		fs: fs,
+		fs:       fs,
+		importer: importer,
+		maxSize:  DefaultMaxDataFileSize,
 	}
 }
 
+// SetMaxSize sets the maximum size of data files in bytes.
+// If maxSize is 0, DefaultMaxDataFileSize is used.
+// If maxSize is negative, the size isn't limited.
+func (dp *DataFileParser) SetMaxSize(maxSize int64) {
+	if maxSize == 0 {
+		maxSize = DefaultMaxDataFileSize
+	}
+	dp.maxSize = maxSize
+}
+
+// Parse reads and parses a data file.
+// A compressed file such as foo.json.gz is decompressed and parsed according to the inner file name.
+// If the file is larger than the max size or binary, *domain.SkipError is returned.
 func (dp *DataFileParser) Parse(filePath *domain.Path) (*domain.TopLevelArgument, error) {
-	unmarshaler, fileType, err := NewUnmarshaler(filePath.Abs)
+	fileName, decompress := splitCompression(filePath.Abs)
+	fileType := filePath.FileType
+	if fileType == "" {
+		fileType = FileType(fileName)
+	}
+	unmarshaler, err := newUnmarshaler(fileType, filePath.ParseOption, dp.importer, filePath.Abs)
 	if err != nil {
 		return nil, slogerr.With(err, "file_path", filePath.Raw) //nolint:wrapcheck
 	}
-	b, err := afero.ReadFile(dp.fs, filePath.Abs)
+	b, err := readDataFile(dp.fs, filePath.Abs, decompress, dp.maxSize)
 	if err != nil {
-		return nil, fmt.Errorf("read a file: %w", err)
+		return nil, err
 	}
-	input, err := unmarshaler.Unmarshal(b)
+	input, metadata, err := unmarshal(unmarshaler, b)
 	if err != nil {
 		return nil, fmt.Errorf("decode a file: %w", err)
 	}
@@ -38,6 +61,15 @@ func (dp *DataFileParser) Parse(filePath *domain.Path) (*domain.TopLevelArgument
 			FilePath: filePath.Raw,
 			FileType: fileType,
 			Value:    input,
+			Metadata: metadata,
 		},
 	}, nil
 }
+
+func unmarshal(unmarshaler Unmarshaler, b []byte) (any, any, error) {
+	if mu, ok := unmarshaler.(metadataUnmarshaler); ok {
+		return mu.UnmarshalWithMetadata(b) //nolint:wrapcheck
+	}
+	input, err := unmarshaler.Unmarshal(b)
+	return input, nil, err //nolint:wrapcheck
+}
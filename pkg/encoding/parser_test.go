@@ -0,0 +1,444 @@
+package encoding_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gojsonnet "github.com/google/go-jsonnet"
+	"github.com/klauspost/compress/zstd"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/encoding"
+	"github.com/lintnet/lintnet/pkg/testutil"
+)
+
+func gzipString(t *testing.T, s string) string {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write([]byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func zstdString(t *testing.T, s string) string {
+	t.Helper()
+	w, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	return string(w.EncodeAll([]byte(s), nil))
+}
+
+func TestDataFileParser_Parse(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	data := []struct {
+		name     string
+		files    map[string]string
+		filePath *domain.Path
+		maxSize  int64
+		exp      *domain.Data
+		isSkip   bool
+		isErr    bool
+	}{
+		{
+			name: "gzip",
+			files: map[string]string{
+				"hello.json.gz": gzipString(t, `{"name": "foo"}`),
+			},
+			filePath: &domain.Path{
+				Raw: "hello.json.gz",
+				Abs: "hello.json.gz",
+			},
+			exp: &domain.Data{
+				Text:     `{"name": "foo"}`,
+				FilePath: "hello.json.gz",
+				FileType: "json",
+				Value:    map[string]any{"name": "foo"},
+			},
+		},
+		{
+			name: "zstd",
+			files: map[string]string{
+				"hello.yaml.zst": zstdString(t, "name: foo\n"),
+			},
+			filePath: &domain.Path{
+				Raw: "hello.yaml.zst",
+				Abs: "hello.yaml.zst",
+			},
+			exp: &domain.Data{
+				Text:     "name: foo\n",
+				FilePath: "hello.yaml.zst",
+				FileType: "yaml",
+				Value: []any{
+					map[string]any{"name": "foo"},
+				},
+			},
+		},
+		{
+			name: "too large",
+			files: map[string]string{
+				"hello.json": `{"name": "foo"}`,
+			},
+			filePath: &domain.Path{
+				Raw: "hello.json",
+				Abs: "hello.json",
+			},
+			maxSize: 10,
+			isSkip:  true,
+		},
+		{
+			name: "too large after decompression",
+			files: map[string]string{
+				"hello.json.gz": gzipString(t, `{"name": "`+strings.Repeat("a", 1000)+`"}`),
+			},
+			filePath: &domain.Path{
+				Raw: "hello.json.gz",
+				Abs: "hello.json.gz",
+			},
+			maxSize: 500,
+			isSkip:  true,
+		},
+		{
+			name: "no limit",
+			files: map[string]string{
+				"hello.txt": "hello",
+			},
+			filePath: &domain.Path{
+				Raw: "hello.txt",
+				Abs: "hello.txt",
+			},
+			maxSize: -1,
+			exp: &domain.Data{
+				Text:     "hello",
+				FilePath: "hello.txt",
+				FileType: "plain_text",
+			},
+		},
+		{
+			name: "binary",
+			files: map[string]string{
+				"hello.bin": "\x00\x01\x02",
+			},
+			filePath: &domain.Path{
+				Raw: "hello.bin",
+				Abs: "hello.bin",
+			},
+			isSkip: true,
+		},
+		{
+			name: "yaml",
+			files: map[string]string{
+				"hello.yaml": "name: foo\n",
+			},
+			filePath: &domain.Path{
+				Raw: "hello.yaml",
+				Abs: "hello.yaml",
+			},
+			exp: &domain.Data{
+				Text:     "name: foo\n",
+				FilePath: "hello.yaml",
+				FileType: "yaml",
+				Value: []any{
+					map[string]any{"name": "foo"},
+				},
+			},
+		},
+		{
+			name: "yaml duplicate keys",
+			files: map[string]string{
+				"hello.yaml": "name: foo\nname: bar\n",
+			},
+			filePath: &domain.Path{
+				Raw: "hello.yaml",
+				Abs: "hello.yaml",
+			},
+			isErr: true,
+		},
+		{
+			name: "yaml metadata",
+			files: map[string]string{
+				"hello.yaml": `default: &default
+  image: alpine
+foo:
+  <<: *default
+  app.kubernetes.io/name: foo
+  name: foo
+  name: bar
+bar: *default
+items:
+  - a
+`,
+			},
+			filePath: &domain.Path{
+				Raw:      "hello.yaml",
+				Abs:      "hello.yaml",
+				FileType: "yaml",
+				ParseOption: &domain.ParseOption{
+					YAML: &domain.YAMLOption{
+						Metadata: true,
+					},
+				},
+			},
+			exp: &domain.Data{
+				Text: `default: &default
+  image: alpine
+foo:
+  <<: *default
+  app.kubernetes.io/name: foo
+  name: foo
+  name: bar
+bar: *default
+items:
+  - a
+`,
+				FilePath: "hello.yaml",
+				FileType: "yaml",
+				Value: []any{
+					map[string]any{
+						"default": map[string]any{"image": "alpine"},
+						"foo": map[string]any{
+							"image":                  "alpine",
+							"app.kubernetes.io/name": "foo",
+							"name":                   "bar",
+						},
+						"bar":   map[string]any{"image": "alpine"},
+						"items": []any{"a"},
+					},
+				},
+				Metadata: []any{
+					map[string]any{
+						"positions": map[string]any{
+							"$":                               map[string]any{"line": 1, "column": 1},
+							"$.default":                       map[string]any{"line": 1, "column": 1},
+							"$.default.image":                 map[string]any{"line": 2, "column": 3},
+							"$.foo":                           map[string]any{"line": 3, "column": 1},
+							`$.foo["app.kubernetes.io/name"]`: map[string]any{"line": 5, "column": 3},
+							"$.foo.name":                      map[string]any{"line": 7, "column": 3},
+							"$.bar":                           map[string]any{"line": 8, "column": 1},
+							"$.items":                         map[string]any{"line": 9, "column": 1},
+							"$.items[0]":                      map[string]any{"line": 10, "column": 5},
+						},
+						"duplicate_keys": []any{
+							map[string]any{
+								"path":         "$.foo.name",
+								"key":          "name",
+								"line":         7,
+								"column":       3,
+								"first_line":   6,
+								"first_column": 3,
+							},
+						},
+						"anchors": []any{
+							map[string]any{"name": "default", "path": "$.default", "line": 1, "column": 10},
+						},
+						"aliases": []any{
+							map[string]any{"anchor": "default", "path": "$.foo", "line": 4, "column": 7},
+							map[string]any{"anchor": "default", "path": "$.bar", "line": 8, "column": 6},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "csv mismatched rows",
+			files: map[string]string{
+				"hello.csv": "name,age\nmike\ntom,30\nbob,40,foo\n",
+			},
+			filePath: &domain.Path{
+				Raw: "hello.csv",
+				Abs: "hello.csv",
+				ParseOption: &domain.ParseOption{
+					CSV: &domain.CSVOption{
+						Header: true,
+					},
+				},
+			},
+			exp: &domain.Data{
+				Text:     "name,age\nmike\ntom,30\nbob,40,foo\n",
+				FilePath: "hello.csv",
+				FileType: "csv",
+				Value: []any{
+					map[string]any{"name": "tom", "age": "30"},
+				},
+				Metadata: map[string]any{
+					"errors": []any{
+						map[string]any{"row": 1, "line": 2, "message": "wrong number of fields: expected 2, got 1"},
+						map[string]any{"row": 3, "line": 4, "message": "wrong number of fields: expected 2, got 3"},
+					},
+				},
+			},
+		},
+		{
+			name: "ini duplicate keys",
+			files: map[string]string{
+				".gitconfig": "[remote \"origin\"]\n\turl = foo\n\tfetch = a\n\tfetch = b\n",
+			},
+			filePath: &domain.Path{
+				Raw: ".gitconfig",
+				Abs: ".gitconfig",
+			},
+			exp: &domain.Data{
+				Text:     "[remote \"origin\"]\n\turl = foo\n\tfetch = a\n\tfetch = b\n",
+				FilePath: ".gitconfig",
+				FileType: "ini",
+				Value: map[string]any{
+					`remote "origin"`: map[string]any{
+						"url":   "foo",
+						"fetch": "b",
+					},
+				},
+				Metadata: map[string]any{
+					"duplicate_keys": []any{
+						map[string]any{
+							"section": `remote "origin"`,
+							"key":     "fetch",
+							"values":  []any{"a", "b"},
+							"lines":   []any{3, 4},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "markdown malformed front matter",
+			files: map[string]string{
+				"README.md": "---\ntitle: [\n---\n# Hello\n",
+			},
+			filePath: &domain.Path{
+				Raw: "README.md",
+				Abs: "README.md",
+			},
+			exp: &domain.Data{
+				Text:     "---\ntitle: [\n---\n# Hello\n",
+				FilePath: "README.md",
+				FileType: "markdown",
+				Value: map[string]any{
+					"front_matter": nil,
+					"headings": []any{
+						map[string]any{"level": 1, "text": "Hello", "line": 4},
+					},
+					"links":       []any{},
+					"code_blocks": []any{},
+				},
+				Metadata: map[string]any{
+					"front_matter_error": "parse front matter as YAML: yaml: line 1: did not find expected node content",
+				},
+			},
+		},
+		{
+			name: "jsonnet",
+			files: map[string]string{
+				"dashboard.jsonnet": `local lib = import 'lib.libsonnet';
+function(title) {
+  title: title,
+  env: std.extVar('env'),
+  version: lib.version,
+}
+`,
+			},
+			filePath: &domain.Path{
+				Raw:      "dashboard.jsonnet",
+				Abs:      "dashboard.jsonnet",
+				FileType: "jsonnet",
+				ParseOption: &domain.ParseOption{
+					Jsonnet: &domain.JsonnetOption{
+						TLA: map[string]any{
+							"title": "hello",
+						},
+						ExtVars: map[string]any{
+							"env": "prod",
+						},
+					},
+				},
+			},
+			exp: &domain.Data{
+				Text: `local lib = import 'lib.libsonnet';
+function(title) {
+  title: title,
+  env: std.extVar('env'),
+  version: lib.version,
+}
+`,
+				FilePath: "dashboard.jsonnet",
+				FileType: "jsonnet",
+				Value: map[string]any{
+					"title":   "hello",
+					"env":     "prod",
+					"version": "v1",
+				},
+			},
+		},
+		{
+			name: "jsonnet evaluation error",
+			files: map[string]string{
+				"hello.jsonnet": `{name: error 'invalid'}`,
+			},
+			filePath: &domain.Path{
+				Raw:      "hello.jsonnet",
+				Abs:      "hello.jsonnet",
+				FileType: "jsonnet",
+			},
+			isErr: true,
+		},
+		{
+			// Jsonnet files such as lint files aren't evaluated unless file_types is configured.
+			name: "jsonnet is plain text by default",
+			files: map[string]string{
+				"hello.jsonnet": `function(param) []`,
+			},
+			filePath: &domain.Path{
+				Raw: "hello.jsonnet",
+				Abs: "hello.jsonnet",
+			},
+			exp: &domain.Data{
+				Text:     `function(param) []`,
+				FilePath: "hello.jsonnet",
+				FileType: "plain_text",
+			},
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			fs, err := testutil.NewFs(d.files)
+			if err != nil {
+				t.Fatal(err)
+			}
+			parser := encoding.NewDataFileParser(fs, &gojsonnet.MemoryImporter{
+				Data: map[string]gojsonnet.Contents{
+					"lib.libsonnet": gojsonnet.MakeContents(`{version: 'v1'}`),
+				},
+			})
+			parser.SetMaxSize(d.maxSize)
+			tla, err := parser.Parse(d.filePath)
+			if err != nil {
+				var skipErr *domain.SkipError
+				if errors.As(err, &skipErr) {
+					if d.isSkip {
+						return
+					}
+					t.Fatal(err)
+				}
+				if d.isErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			if d.isErr || d.isSkip {
+				t.Fatal("error must be returned")
+			}
+			if diff := cmp.Diff(d.exp, tla.Data); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package encoding
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// propertiesUnmarshaler parses Java properties files and returns a flat map of keys and values.
+// Keys and values are separated by `=`, `:`, or white spaces.
+// Lines starting with `#` or `!` are comments.
+// A line ending with an odd number of backslashes continues to the next line.
+// Escape sequences such as `\t`, `\n`, and `\uXXXX` are expanded.
+// Duplicate keys are treated as errors.
+type propertiesUnmarshaler struct{}
+
+func (d *propertiesUnmarshaler) Unmarshal(b []byte) (any, error) {
+	values := map[string]any{}
+	keyLines := map[string]int{}
+	lines := splitLines(string(b))
+	for i := 0; i < len(lines); i++ {
+		lineNum := i + 1
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		// Join continuation lines.
+		for endsWithBackslash(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+		rawKey, rawValue := splitProperty(line)
+		key, err := unescapeProperty(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("parse a file as properties: line %d: %w", lineNum, err)
+		}
+		value, err := unescapeProperty(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("parse a file as properties: line %d: %w", lineNum, err)
+		}
+		if l, ok := keyLines[key]; ok {
+			return nil, fmt.Errorf("parse a file as properties: line %d: key %s is duplicated with line %d", lineNum, key, l)
+		}
+		keyLines[key] = lineNum
+		values[key] = value
+	}
+	return values, nil
+}
+
+func endsWithBackslash(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// splitProperty splits a line into a key and a value.
+// The key is terminated by the first unescaped `=`, `:`, or white space.
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return line[:i], strings.TrimLeft(line[i+1:], " \t\f")
+		case ' ', '\t', '\f':
+			rest := strings.TrimLeft(line[i:], " \t\f")
+			if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+				rest = strings.TrimLeft(rest[1:], " \t\f")
+			}
+			return line[:i], rest
+		}
+	}
+	return line, ""
+}
+
+func unescapeProperty(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var builder strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			builder.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", fmt.Errorf("invalid unicode escape: %s", s[i-1:])
+			}
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape: %s", s[i-1:i+5])
+			}
+			builder.WriteRune(rune(r))
+			i += 4
+		default:
+			builder.WriteByte(s[i])
+		}
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,105 @@
+package encoding
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/spf13/afero"
+)
+
+// DefaultMaxDataFileSize is the default maximum size of a data file in bytes.
+const DefaultMaxDataFileSize = 100 * 1024 * 1024
+
+// binaryDetectionSize is the size of the leading bytes to detect binary files.
+// This is same as Git.
+const binaryDetectionSize = 8000
+
+type decompressor func(r io.Reader) (io.ReadCloser, error)
+
+// splitCompression returns the file name without the compression extension and the decompressor.
+// e.g. foo.json.gz => foo.json
+// If the file isn't compressed, the decompressor is nil.
+func splitCompression(fileName string) (string, decompressor) {
+	ext := filepath.Ext(fileName)
+	switch ext {
+	case ".gz":
+		return strings.TrimSuffix(fileName, ext), func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r) //nolint:wrapcheck
+		}
+	case ".zst", ".zstd":
+		return strings.TrimSuffix(fileName, ext), func(r io.Reader) (io.ReadCloser, error) {
+			d, err := zstd.NewReader(r)
+			if err != nil {
+				return nil, err //nolint:wrapcheck
+			}
+			return d.IOReadCloser(), nil
+		}
+	default:
+		return fileName, nil
+	}
+}
+
+// readDataFile reads a data file.
+// If the file is compressed, the file is decompressed.
+// If the file is larger than maxSize or binary, *domain.SkipError is returned.
+// If maxSize is negative, the size isn't limited.
+func readDataFile(fs afero.Fs, filePath string, decompress decompressor, maxSize int64) ([]byte, error) {
+	f, err := fs.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open a file: %w", err)
+	}
+	defer f.Close()
+	if maxSize >= 0 {
+		stat, err := f.Stat()
+		if err != nil {
+			return nil, fmt.Errorf("get a file stat: %w", err)
+		}
+		if stat.Size() > maxSize {
+			return nil, &domain.SkipError{
+				Reason: fmt.Sprintf("the file size %d bytes exceeds the max data file size %d bytes", stat.Size(), maxSize),
+			}
+		}
+	}
+	var r io.Reader = f
+	if decompress != nil {
+		rc, err := decompress(f)
+		if err != nil {
+			return nil, fmt.Errorf("decompress a file: %w", err)
+		}
+		defer rc.Close()
+		r = rc
+	}
+	if maxSize >= 0 {
+		// Limit the decompressed size too.
+		r = io.LimitReader(r, maxSize+1)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read a file: %w", err)
+	}
+	if maxSize >= 0 && int64(len(b)) > maxSize {
+		return nil, &domain.SkipError{
+			Reason: fmt.Sprintf("the decompressed file size exceeds the max data file size %d bytes", maxSize),
+		}
+	}
+	if isBinary(b) {
+		return nil, &domain.SkipError{
+			Reason: "the file is binary",
+		}
+	}
+	return b, nil
+}
+
+// isBinary returns true if the leading bytes include a NUL byte.
+func isBinary(b []byte) bool {
+	if len(b) > binaryDetectionSize {
+		b = b[:binaryDetectionSize]
+	}
+	return bytes.IndexByte(b, 0) >= 0
+}
@@ -0,0 +1,193 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+)
+
+// xmlUnmarshaler parses XML and converts the root element to the following object.
+//
+//	{
+//	  "name": "android:label", // the qualified name as written
+//	  "namespace": "http://schemas.android.com/apk/res/android", // the resolved namespace URI. This is omitted if the element has no namespace
+//	  "namespaces": {"android": "http://schemas.android.com/apk/res/android"}, // namespaces declared in the element. The default namespace's key is an empty string. This is omitted if no namespace is declared
+//	  "attributes": {"android:name": "foo"}, // keys are qualified names as written. Namespace declarations aren't included
+//	  "children": [], // child elements
+//	  "text": "hello", // the concatenation of character data of the element without leading and trailing white spaces
+//	  "content": ["hello", {...}, "world"], // character data and child elements in document order. This is set only if the element has mixed content
+//	}
+//
+// Comments, processing instructions, and directives are ignored.
+type xmlUnmarshaler struct{}
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+type xmlElement struct {
+	name     string
+	attrs    map[string]any
+	children []any
+	content  []any
+	text     strings.Builder
+	value    map[string]any
+	scope    map[string]string
+	hasChild bool
+	hasText  bool
+}
+
+func (x *xmlUnmarshaler) Unmarshal(b []byte) (any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	var (
+		stack []*xmlElement
+		root  map[string]any
+	)
+	for {
+		token, err := decoder.RawToken()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("parse a file as XML: %w", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if len(stack) == 0 && root != nil {
+				return nil, errors.New("parse a file as XML: multiple root elements are found")
+			}
+			var parentScope map[string]string
+			if len(stack) > 0 {
+				parentScope = stack[len(stack)-1].scope
+			}
+			elem := newXMLElement(t, parentScope)
+			if len(stack) > 0 {
+				stack[len(stack)-1].addChild(elem.value)
+			}
+			stack = append(stack, elem)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("parse a file as XML: unexpected end element %s", xmlName(t.Name))
+			}
+			elem := stack[len(stack)-1]
+			// RawToken doesn't verify that start and end elements match.
+			if name := xmlName(t.Name); name != elem.name {
+				return nil, fmt.Errorf("parse a file as XML: element <%s> is closed by </%s>", elem.name, name)
+			}
+			stack = stack[:len(stack)-1]
+			elem.build()
+			if len(stack) == 0 {
+				root = elem.value
+			}
+		case xml.CharData:
+			if len(stack) == 0 {
+				if len(bytes.TrimSpace(t)) > 0 {
+					return nil, errors.New("parse a file as XML: character data outside the root element")
+				}
+				continue
+			}
+			stack[len(stack)-1].addText(string(t))
+		}
+	}
+	if len(stack) > 0 {
+		return nil, errors.New("parse a file as XML: unexpected EOF")
+	}
+	if root == nil {
+		return nil, errors.New("parse a file as XML: no root element")
+	}
+	return root, nil
+}
+
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func newXMLElement(t xml.StartElement, parentScope map[string]string) *xmlElement {
+	name := xmlName(t.Name)
+	elem := &xmlElement{
+		name:  name,
+		attrs: map[string]any{},
+		scope: parentScope,
+		value: map[string]any{
+			"name": name,
+		},
+	}
+	namespaces := map[string]any{}
+	for _, attr := range t.Attr {
+		switch {
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+			namespaces[""] = attr.Value
+		case attr.Name.Space == "xmlns":
+			namespaces[attr.Name.Local] = attr.Value
+		default:
+			elem.attrs[xmlName(attr.Name)] = attr.Value
+		}
+	}
+	if len(namespaces) > 0 {
+		// Copy the scope so that namespaces declared in the element don't affect sibling elements.
+		scope := make(map[string]string, len(parentScope)+len(namespaces))
+		maps.Copy(scope, parentScope)
+		for k, v := range namespaces {
+			scope[k] = v.(string) //nolint:forcetypeassert
+		}
+		elem.scope = scope
+		elem.value["namespaces"] = namespaces
+	}
+	if ns := elem.resolve(t.Name.Space); ns != "" {
+		elem.value["namespace"] = ns
+	}
+	elem.value["attributes"] = elem.attrs
+	return elem
+}
+
+// resolve returns the namespace URI of the prefix.
+// If the prefix isn't declared, an empty string is returned.
+func (e *xmlElement) resolve(prefix string) string {
+	if prefix == "xml" {
+		return xmlNamespace
+	}
+	return e.scope[prefix]
+}
+
+func (e *xmlElement) addChild(child map[string]any) {
+	e.hasChild = true
+	e.children = append(e.children, child)
+	e.content = append(e.content, child)
+}
+
+func (e *xmlElement) addText(s string) {
+	e.text.WriteString(s)
+	if strings.TrimSpace(s) == "" {
+		return
+	}
+	e.hasText = true
+	// Merge adjacent character data such as text and CDATA sections.
+	if n := len(e.content); n > 0 {
+		if prev, ok := e.content[n-1].(string); ok {
+			e.content[n-1] = prev + s
+			return
+		}
+	}
+	e.content = append(e.content, s)
+}
+
+func (e *xmlElement) build() {
+	if e.children == nil {
+		e.children = []any{}
+	}
+	e.value["children"] = e.children
+	e.value["text"] = strings.TrimSpace(e.text.String())
+	if e.hasChild && e.hasText {
+		for i, c := range e.content {
+			if s, ok := c.(string); ok {
+				e.content[i] = strings.TrimSpace(s)
+			}
+		}
+		e.value["content"] = e.content
+	}
+}
@@ -0,0 +1,187 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/suzuki-shunsuke/go-convmap/convmap"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlMetadataUnmarshaler parses YAML as multiple documents like yamlUnmarshaler and returns metadata of each document.
+// Metadata is the following object.
+//
+//	{
+//	  "positions": {"$.spec.containers[0].image": {"line": 5, "column": 9}},
+//	  "duplicate_keys": [{"path": "$.name", "key": "name", "line": 3, "column": 1, "first_line": 1, "first_column": 1}],
+//	  "anchors": [{"name": "default", "path": "$.default", "line": 1, "column": 10}],
+//	  "aliases": [{"anchor": "default", "path": "$.foo", "line": 4, "column": 6}],
+//	}
+//
+// The position of a mapping value is the position of the key.
+// Duplicate keys aren't treated as errors, and the last value wins.
+type yamlMetadataUnmarshaler struct{}
+
+type yamlMetadata struct {
+	positions     map[string]any
+	duplicateKeys []any
+	anchors       []any
+	aliases       []any
+}
+
+func (d *yamlMetadataUnmarshaler) Unmarshal(b []byte) (any, error) {
+	value, _, err := d.UnmarshalWithMetadata(b)
+	return value, err
+}
+
+func (d *yamlMetadataUnmarshaler) UnmarshalWithMetadata(b []byte) (any, any, error) {
+	var values, metadata []any
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		var node yaml.Node
+		err := dec.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			return values, metadata, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("decode YAML: %w", err)
+		}
+		meta := &yamlMetadata{
+			positions:     map[string]any{},
+			duplicateKeys: []any{},
+			anchors:       []any{},
+			aliases:       []any{},
+		}
+		for _, content := range node.Content {
+			meta.walk(content, "$", content)
+		}
+		// Duplicate keys are removed by walk, so Decode doesn't fail.
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return nil, nil, fmt.Errorf("decode YAML: %w", err)
+		}
+		v, err := convmap.Convert(value, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("convert map keys to string: %w", err)
+		}
+		values = append(values, v)
+		metadata = append(metadata, map[string]any{
+			"positions":      meta.positions,
+			"duplicate_keys": meta.duplicateKeys,
+			"anchors":        meta.anchors,
+			"aliases":        meta.aliases,
+		})
+	}
+}
+
+// walk records metadata of the node.
+// pos is the node whose position is recorded as the position of the path.
+func (m *yamlMetadata) walk(node *yaml.Node, path string, pos *yaml.Node) {
+	m.positions[path] = yamlPosition(pos)
+	if node.Anchor != "" {
+		m.anchors = append(m.anchors, map[string]any{
+			"name":   node.Anchor,
+			"path":   path,
+			"line":   node.Line,
+			"column": node.Column,
+		})
+	}
+	switch node.Kind { //nolint:exhaustive
+	case yaml.MappingNode:
+		m.walkMapping(node, path)
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			m.walk(item, path+"["+strconv.Itoa(i)+"]", item)
+		}
+	case yaml.AliasNode:
+		m.aliases = append(m.aliases, map[string]any{
+			"anchor": node.Value,
+			"path":   path,
+			"line":   node.Line,
+			"column": node.Column,
+		})
+	}
+}
+
+func (m *yamlMetadata) walkMapping(node *yaml.Node, path string) {
+	seen := map[string]int{}
+	removed := map[int]struct{}{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		value := node.Content[i+1]
+		if key.Tag == "!!merge" {
+			m.walkMerge(value, path)
+			continue
+		}
+		childPath := path + yamlPathKey(key.Value)
+		if prev, ok := seen[key.Value]; ok {
+			first := node.Content[prev]
+			m.duplicateKeys = append(m.duplicateKeys, map[string]any{
+				"path":         childPath,
+				"key":          key.Value,
+				"line":         key.Line,
+				"column":       key.Column,
+				"first_line":   first.Line,
+				"first_column": first.Column,
+			})
+			removed[prev] = struct{}{}
+		}
+		seen[key.Value] = i
+		m.walk(value, childPath, key)
+	}
+	if len(removed) == 0 {
+		return
+	}
+	content := make([]*yaml.Node, 0, len(node.Content)-len(removed)*2) //nolint:mnd
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if _, ok := removed[i]; ok {
+			continue
+		}
+		content = append(content, node.Content[i], node.Content[i+1])
+	}
+	node.Content = content
+}
+
+// walkMerge records aliases of merge keys `<<`.
+func (m *yamlMetadata) walkMerge(node *yaml.Node, path string) {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.AliasNode:
+		m.aliases = append(m.aliases, map[string]any{
+			"anchor": node.Value,
+			"path":   path,
+			"line":   node.Line,
+			"column": node.Column,
+		})
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			m.walkMerge(item, path)
+		}
+	}
+}
+
+func yamlPosition(node *yaml.Node) map[string]any {
+	return map[string]any{
+		"line":   node.Line,
+		"column": node.Column,
+	}
+}
+
+var yamlIdentifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`) //nolint:gochecknoglobals
+
+// yamlPathKey returns a path element of the key.
+// e.g. ".name", `["app.kubernetes.io/name"]`
+func yamlPathKey(key string) string {
+	if yamlIdentifierPattern.MatchString(key) {
+		return "." + key
+	}
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "." + key
+	}
+	return "[" + string(b) + "]"
+}
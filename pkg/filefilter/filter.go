@@ -52,7 +52,10 @@ func filterTargets(targets []*filefind.Target, filePaths []string) []*filefind.T
 }
 
 func filterTarget(target *filefind.Target, filePaths []string) *filefind.Target {
-	newTarget := &filefind.Target{}
+	newTarget := &filefind.Target{
+		ID:    target.ID,
+		Index: target.Index,
+	}
 	for _, lintFile := range target.LintFiles {
 		for _, filePath := range filePaths {
 			if checkIfLintFileChanged(lintFile.Path, filePath) {
@@ -0,0 +1,92 @@
+package filefind
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/lintnet/lintnet/pkg/config"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/encoding"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
+)
+
+// fileTypeMatcher sets file types to data files based on the mappings of glob patterns to file types.
+type fileTypeMatcher struct {
+	patterns []string
+	types    config.FileTypes
+}
+
+// newFileTypeMatcher returns a fileTypeMatcher.
+// If multiple patterns match a data file, the longest pattern wins because the longer pattern is usually more specific.
+func newFileTypeMatcher(fileTypes config.FileTypes, cfgDir string) (*fileTypeMatcher, error) {
+	m := &fileTypeMatcher{
+		patterns: make([]string, 0, len(fileTypes)),
+		types:    make(config.FileTypes, len(fileTypes)),
+	}
+	for pattern, ft := range fileTypes {
+		if _, err := encoding.NewUnmarshalerByFileType(ft.Type, ft.ParseOption()); err != nil {
+			return nil, slogerr.With(err, "pattern", pattern) //nolint:wrapcheck
+		}
+		p := filepath.FromSlash(pattern)
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(cfgDir, p)
+		}
+		m.patterns = append(m.patterns, p)
+		m.types[p] = ft
+	}
+	sort.Slice(m.patterns, func(i, j int) bool {
+		a, b := m.patterns[i], m.patterns[j]
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a < b
+	})
+	return m, nil
+}
+
+func (m *fileTypeMatcher) match(dataFile *domain.Path) (*config.FileType, error) {
+	for _, pattern := range m.patterns {
+		matched, err := doublestar.PathMatch(pattern, dataFile.Abs)
+		if err != nil {
+			return nil, fmt.Errorf("check file match: %w", err)
+		}
+		if matched {
+			return m.types[pattern], nil
+		}
+	}
+	return nil, nil //nolint:nilnil
+}
+
+// SetFileTypes sets file types to data files.
+// File types of the target take precedence over global file types.
+func SetFileTypes(targets []*Target, targetFileTypes, globalFileTypes config.FileTypes, cfgDir string) error {
+	if len(targetFileTypes) == 0 && len(globalFileTypes) == 0 {
+		return nil
+	}
+	matchers := make([]*fileTypeMatcher, 0, 2) //nolint:mnd
+	for _, fileTypes := range []config.FileTypes{targetFileTypes, globalFileTypes} {
+		m, err := newFileTypeMatcher(fileTypes, cfgDir)
+		if err != nil {
+			return err
+		}
+		matchers = append(matchers, m)
+	}
+	for _, target := range targets {
+		for _, dataFile := range target.DataFiles {
+			for _, m := range matchers {
+				ft, err := m.match(dataFile)
+				if err != nil {
+					return err
+				}
+				if ft != nil {
+					dataFile.FileType = ft.Type
+					dataFile.ParseOption = ft.ParseOption()
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -14,10 +14,14 @@ import (
 	"github.com/lintnet/lintnet/pkg/domain"
 	"github.com/lintnet/lintnet/pkg/log"
 	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
 )
 
 type Target struct {
-	ID        string             `json:"id,omitempty"`
+	ID string `json:"id,omitempty"`
+	// Index is the index of the target in the configuration file.
+	// It identifies targets without id.
+	Index     int                `json:"index"`
 	LintFiles []*config.LintFile `json:"lint_files,omitempty"`
 	DataFiles domain.Paths       `json:"data_files,omitempty"`
 }
@@ -38,13 +42,17 @@ func (f *FileFinder) Find(logger *slog.Logger, cfg *config.Config, rootDir, cfgD
 	}
 
 	targets := make([]*Target, 0, len(cfg.Targets))
-	for _, target := range cfg.Targets {
+	for i, target := range cfg.Targets {
 		ts, err := f.findTarget(logger, target, rootDir, cfgDir, cfg.IgnoredPatterns)
 		if err != nil {
 			return nil, err
 		}
 		for _, t := range ts {
 			t.ID = target.ID
+			t.Index = i
+		}
+		if err := SetFileTypes(ts, target.FileTypes, cfg.FileTypes, cfgDir); err != nil {
+			return nil, fmt.Errorf("set file types: %w", slogerr.With(err, "target_id", target.ID))
 		}
 		targets = append(targets, ts...)
 	}
@@ -319,7 +327,12 @@ func (f *FileFinder) findFilesFromPaths(files []*config.DataFile, cfgDir string,
 			return nil, err
 		}
 	}
-	return slices.Collect(maps.Values(matchFiles)), nil
+	// Data files are sorted so that results are outputted in the same order every time.
+	paths := make([]*domain.Path, 0, len(matchFiles))
+	for _, k := range slices.Sorted(maps.Keys(matchFiles)) {
+		paths = append(paths, matchFiles[k])
+	}
+	return paths, nil
 }
 
 func ignorePath(path string, ignorePatterns []string) error {
@@ -65,6 +65,108 @@ func TestFinder_Find(t *testing.T) { //nolint:funlen
 				},
 			},
 		},
+		{
+			name: "file types",
+			files: map[string]string{
+				"foo.json":      `{}`,
+				"hello.jsonnet": `{}`,
+			},
+			cfg: &config.Config{
+				Targets: []*config.Target{
+					{
+						LintFiles: []*config.LintGlob{
+							{
+								Glob: "*.jsonnet",
+							},
+						},
+						DataFiles: []*config.DataFile{
+							{
+								Path: "*.json",
+							},
+						},
+					},
+				},
+				FileTypes: config.FileTypes{
+					"*.json": {
+						Type: "json",
+					},
+					"foo.json": {
+						Type: "jsonc",
+					},
+				},
+			},
+			rootDir: "/home/foo/.local/share/lintnet",
+			cfgDir:  "",
+			targets: []*filefind.Target{
+				{
+					DataFiles: domain.Paths{
+						{
+							Raw:      "foo.json",
+							Abs:      "foo.json",
+							FileType: "jsonc",
+						},
+					},
+					LintFiles: []*config.LintFile{
+						{
+							ID:   "hello.jsonnet",
+							Path: "hello.jsonnet",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "file types of target take precedence",
+			files: map[string]string{
+				"foo.json":      `{}`,
+				"hello.jsonnet": `{}`,
+			},
+			cfg: &config.Config{
+				Targets: []*config.Target{
+					{
+						LintFiles: []*config.LintGlob{
+							{
+								Glob: "*.jsonnet",
+							},
+						},
+						DataFiles: []*config.DataFile{
+							{
+								Path: "*.json",
+							},
+						},
+						FileTypes: config.FileTypes{
+							"*.json": {
+								Type: "plain_text",
+							},
+						},
+					},
+				},
+				FileTypes: config.FileTypes{
+					"foo.json": {
+						Type: "jsonc",
+					},
+				},
+			},
+			rootDir: "/home/foo/.local/share/lintnet",
+			cfgDir:  "",
+			targets: []*filefind.Target{
+				{
+					DataFiles: domain.Paths{
+						{
+							Raw:      "foo.json",
+							Abs:      "foo.json",
+							FileType: "plain_text",
+						},
+					},
+					LintFiles: []*config.LintFile{
+						{
+							ID:   "hello.jsonnet",
+							Path: "hello.jsonnet",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
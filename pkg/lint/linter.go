@@ -1,13 +1,13 @@
 package lint
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/lintnet/lintnet/pkg/config"
 	"github.com/lintnet/lintnet/pkg/domain"
 	"github.com/lintnet/lintnet/pkg/filefind"
 	"github.com/lintnet/lintnet/pkg/jsonnet"
-	"github.com/suzuki-shunsuke/slog-error/slogerr"
 )
 
 type Linter struct {
@@ -38,25 +38,43 @@ type LintFileEvaluator interface { //nolint:revive
 	Evaluates(tla *domain.TopLevelArgument, lintFiles []*domain.Node) []*domain.Result
 }
 
+// ResultHandler handles results as soon as lint files are evaluated.
+type ResultHandler func(results []*domain.Result) error
+
 func (l *Linter) Lint(targets []*filefind.Target) ([]*domain.Result, error) {
 	results := make([]*domain.Result, 0, len(targets))
-	for _, target := range targets {
-		rs, err := l.lintTarget(target)
-		if err != nil {
-			return nil, err
-		}
-		for _, r := range rs {
-			r.TargetID = target.ID
-		}
+	if err := l.LintStream(targets, func(rs []*domain.Result) error {
 		results = append(results, rs...)
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 	return results, nil
 }
 
-func (l *Linter) lintTarget(target *filefind.Target) ([]*domain.Result, error) {
+// LintStream lints targets and passes results to handler as soon as lint files are evaluated against each data file.
+// Results aren't kept, so the memory usage doesn't depend on the number of results.
+func (l *Linter) LintStream(targets []*filefind.Target, handler ResultHandler) error {
+	for _, target := range targets {
+		if err := l.lintTarget(target, func(rs []*domain.Result) error {
+			for _, r := range rs {
+				r.TargetID = target.ID
+				if target.ID == "" {
+					r.TargetIndex = &target.Index
+				}
+			}
+			return handler(rs)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Linter) lintTarget(target *filefind.Target, handler ResultHandler) error {
 	lintFiles, err := l.lintFileParser.Parses(target.LintFiles)
 	if err != nil {
-		return nil, fmt.Errorf("parse lint files: %w", err)
+		return fmt.Errorf("parse lint files: %w", err)
 	}
 
 	combineFiles := []*domain.Node{}
@@ -69,20 +87,29 @@ func (l *Linter) lintTarget(target *filefind.Target) ([]*domain.Result, error) {
 		nonCombineFiles = append(nonCombineFiles, lintFile)
 	}
 
-	results := l.lintNonCombineFiles(target, nonCombineFiles)
+	// Data files aren't parsed for non combine files if there is no non combine file.
+	if len(nonCombineFiles) > 0 {
+		if err := l.lintNonCombineFiles(target, nonCombineFiles, handler); err != nil {
+			return err
+		}
+	}
 
 	if len(combineFiles) > 0 {
-		rs, err := l.lintCombineFiles(target, combineFiles)
+		// Results of skipped data files and data files failing to be parsed are reported once per target.
+		// If non combine files are linted, they have already been reported.
+		rs, err := l.lintCombineFiles(target, combineFiles, len(nonCombineFiles) == 0)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		return append(results, rs...), nil
+		return handler(rs)
 	}
-	return results, nil
+	return nil
 }
 
-func (l *Linter) lintCombineFiles(target *filefind.Target, combineFiles []*domain.Node) ([]*domain.Result, error) {
-	rs, err := l.lint(&domain.DataSet{
+// lintCombineFiles lints data files of the target with combine files.
+// If reportDataFiles is false, results of skipped data files and data files failing to be parsed aren't returned.
+func (l *Linter) lintCombineFiles(target *filefind.Target, combineFiles []*domain.Node, reportDataFiles bool) ([]*domain.Result, error) {
+	rs, skipped, err := l.lint(&domain.DataSet{
 		Files: target.DataFiles,
 	}, combineFiles)
 	if err != nil {
@@ -95,26 +122,34 @@ func (l *Linter) lintCombineFiles(target *filefind.Target, combineFiles []*domai
 		}
 		r.DataFiles = arr
 	}
-	return rs, nil
+	if !reportDataFiles {
+		return rs, nil
+	}
+	return append(rs, skipped...), nil
 }
 
-func (l *Linter) lintNonCombineFiles(target *filefind.Target, nonCombineFiles []*domain.Node) []*domain.Result {
-	results := make([]*domain.Result, 0, len(target.DataFiles))
+func (l *Linter) lintNonCombineFiles(target *filefind.Target, nonCombineFiles []*domain.Node, handler ResultHandler) error {
 	for _, dataFile := range target.DataFiles {
-		results = append(results, l.lintNonCombineFile(nonCombineFiles, dataFile)...)
+		if err := handler(l.lintNonCombineFile(nonCombineFiles, dataFile)); err != nil {
+			return err
+		}
 	}
-	return results
+	return nil
 }
 
 func (l *Linter) lintNonCombineFile(nonCombineFiles []*domain.Node, dataFile *domain.Path) []*domain.Result {
-	rs, err := l.lint(&domain.DataSet{
+	rs, skipped, err := l.lint(&domain.DataSet{
 		File: dataFile,
 	}, nonCombineFiles)
+	if len(skipped) > 0 {
+		return skipped
+	}
 	if err != nil {
 		return []*domain.Result{
 			{
-				DataFile: dataFile.Raw,
-				Error:    err.Error(),
+				DataFile:      dataFile.Raw,
+				Error:         err.Error(),
+				DataFileError: true,
 			},
 		}
 	}
@@ -124,34 +159,58 @@ func (l *Linter) lintNonCombineFile(nonCombineFiles []*domain.Node, dataFile *do
 	return rs
 }
 
-func (l *Linter) getTLA(dataSet *domain.DataSet) (*domain.TopLevelArgument, error) {
+// getTLA parses data files and returns a top level argument.
+// Data files skipped by the parser aren't included in the top level argument, and warning results of them are returned.
+// In the combine mode, data files failing to be parsed such as Jsonnet evaluation errors aren't included either,
+// and error results of them are returned so that other data files are still linted.
+func (l *Linter) getTLA(dataSet *domain.DataSet) (*domain.TopLevelArgument, []*domain.Result, error) {
 	if dataSet.File != nil {
 		tla, err := l.dataFileParser.Parse(dataSet.File)
 		if err != nil {
-			return nil, fmt.Errorf("parse a data file: %w", err)
+			var skipErr *domain.SkipError
+			if errors.As(err, &skipErr) {
+				return nil, []*domain.Result{domain.SkippedResult(dataSet.File.Raw, skipErr)}, nil
+			}
+			return nil, nil, fmt.Errorf("parse a data file: %w", err)
 		}
-		return tla, nil
+		return tla, nil, nil
 	}
 	if len(dataSet.Files) > 0 {
-		combinedData := make([]*domain.Data, len(dataSet.Files))
-		for i, dataFile := range dataSet.Files {
+		combinedData := make([]*domain.Data, 0, len(dataSet.Files))
+		var skipped []*domain.Result
+		for _, dataFile := range dataSet.Files {
 			data, err := l.dataFileParser.Parse(dataFile)
 			if err != nil {
-				return nil, fmt.Errorf("parse a data file: %w", slogerr.With(err, "data_file", dataFile.Raw))
+				var skipErr *domain.SkipError
+				if errors.As(err, &skipErr) {
+					skipped = append(skipped, domain.SkippedResult(dataFile.Raw, skipErr))
+					continue
+				}
+				skipped = append(skipped, &domain.Result{
+					DataFile:      dataFile.Raw,
+					Error:         fmt.Errorf("parse a data file: %w", err).Error(),
+					DataFileError: true,
+				})
+				continue
 			}
-			combinedData[i] = data.Data
+			combinedData = append(combinedData, data.Data)
 		}
 		return &domain.TopLevelArgument{
 			CombinedData: combinedData,
-		}, nil
+		}, skipped, nil
 	}
-	return &domain.TopLevelArgument{}, nil
+	return &domain.TopLevelArgument{}, nil, nil
 }
 
-func (l *Linter) lint(dataSet *domain.DataSet, lintFiles []*domain.Node) ([]*domain.Result, error) {
-	tla, err := l.getTLA(dataSet)
+// lint evaluates lint files.
+// If a data file is skipped or fails to be parsed in the combine mode, results of it are returned as the second return value.
+func (l *Linter) lint(dataSet *domain.DataSet, lintFiles []*domain.Node) ([]*domain.Result, []*domain.Result, error) {
+	tla, skipped, err := l.getTLA(dataSet)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if tla == nil {
+		return nil, skipped, nil
 	}
-	return l.lintFileEvaluator.Evaluates(tla, lintFiles), nil
+	return l.lintFileEvaluator.Evaluates(tla, lintFiles), skipped, nil
 }
@@ -0,0 +1,99 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// checkstyleOutputter outputs results in Checkstyle XML format.
+// https://checkstyle.sourceforge.io/
+type checkstyleOutputter struct {
+	stdout io.Writer
+}
+
+type checkstyleResult struct {
+	XMLName xml.Name          `xml:"checkstyle"`
+	Version string            `xml:"version,attr"`
+	Files   []*checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string             `xml:"name,attr"`
+	Errors []*checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr,omitempty"`
+	// Fingerprint, Excluded, and ExclusionReason aren't standard attributes of Checkstyle, but tools ignore unknown attributes.
+	Fingerprint     string `xml:"fingerprint,attr,omitempty"`
+	Excluded        bool   `xml:"excluded,attr,omitempty"`
+	ExclusionReason string `xml:"exclusion_reason,attr,omitempty"`
+}
+
+var checkstyleSeverities = map[severity]string{ //nolint:gochecknoglobals
+	severityInfo:    "info",
+	severityWarning: "warning",
+	severityError:   "error",
+}
+
+func (o *checkstyleOutputter) Output(result *Output) error {
+	cr := &checkstyleResult{
+		Version: "4.3",
+		Files:   []*checkstyleFile{},
+	}
+	files := map[string]*checkstyleFile{}
+	add := func(e *domain.Error, ce *checkstyleError) {
+		p := errorPath(e)
+		file, ok := files[p]
+		if !ok {
+			file = &checkstyleFile{
+				Name: p,
+			}
+			files[p] = file
+			cr.Files = append(cr.Files, file)
+		}
+		if rng := parseLocation(e.Location); rng != nil {
+			ce.Line = rng.Start.Line
+			ce.Column = rng.Start.Column
+		}
+		file.Errors = append(file.Errors, ce)
+	}
+	for _, e := range result.Errors {
+		add(e, &checkstyleError{
+			Severity:    checkstyleSeverities[getSeverity(e.Level)],
+			Message:     errorMessage(e),
+			Source:      e.Name,
+			Fingerprint: errorFingerprint(e),
+		})
+	}
+	// Excluded errors are outputted at the info severity so that they don't fail tools.
+	for _, e := range result.Excluded {
+		add(e, &checkstyleError{
+			Severity:        checkstyleSeverities[severityInfo],
+			Message:         excludedMessage(e),
+			Source:          e.Name,
+			Fingerprint:     errorFingerprint(e),
+			Excluded:        true,
+			ExclusionReason: e.ExclusionReason,
+		})
+	}
+	if _, err := io.WriteString(o.stdout, xml.Header); err != nil {
+		return fmt.Errorf("write a XML header: %w", err)
+	}
+	encoder := xml.NewEncoder(o.stdout)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(cr); err != nil {
+		return fmt.Errorf("encode the result as Checkstyle XML: %w", err)
+	}
+	if _, err := io.WriteString(o.stdout, "\n"); err != nil {
+		return fmt.Errorf("write a newline: %w", err)
+	}
+	return nil
+}
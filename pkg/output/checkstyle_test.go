@@ -0,0 +1,102 @@
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+)
+
+func TestCheckstyle(t *testing.T) {
+	t.Parallel()
+	stdout := &bytes.Buffer{}
+	getter := output.NewGetter(stdout, afero.NewMemMapFs(), nil)
+	outputter, err := getter.Get(nil, &output.ParamGet{
+		Format: "checkstyle",
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := outputter.Output(&output.Output{
+		Errors: []*domain.Error{
+			{
+				Name:     "description is required",
+				LintFile: "hello.jsonnet",
+				DataFile: "hello.json",
+				Location: map[string]any{
+					"line":   float64(2),
+					"column": float64(3),
+				},
+			},
+			{
+				Name:     "name is too long",
+				Message:  `name must be shorter than 10 characters: "hello world"`,
+				Level:    "warn",
+				LintFile: "hello.jsonnet",
+				DataFile: "hello.json",
+			},
+			{
+				Name:     "age must be a number",
+				Level:    "info",
+				LintFile: "hello.jsonnet",
+				DataFile: "foo.json",
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	exp := `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="hello.json">
+    <error line="2" column="3" severity="error" message="description is required" source="description is required" fingerprint="c9f0cb046329bd5d40f2dcb9c3aa3e955d8831c65c9d5de75479e46386fdc63f"></error>
+    <error severity="warning" message="name must be shorter than 10 characters: &#34;hello world&#34;" source="name is too long" fingerprint="2866e140dc57c6a7c7011493f09ee0251c92356b4c8017c5a9bbbdbd3d88c290"></error>
+  </file>
+  <file name="foo.json">
+    <error severity="info" message="age must be a number" source="age must be a number" fingerprint="0116d63374e73e37efa793f6aa8c45672d2a0ff59828d20abf2782eff66fe6c9"></error>
+  </file>
+</checkstyle>
+`
+	if diff := cmp.Diff(exp, stdout.String()); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestCheckstyle_excluded(t *testing.T) {
+	t.Parallel()
+	stdout := &bytes.Buffer{}
+	getter := output.NewGetter(stdout, afero.NewMemMapFs(), nil)
+	outputter, err := getter.Get(nil, &output.ParamGet{
+		Format: "checkstyle",
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := outputter.Output(&output.Output{
+		Errors: []*domain.Error{},
+		Excluded: []*domain.Error{
+			{
+				Name:            "description is required",
+				LintFile:        "hello.jsonnet",
+				DataFile:        "hello.json",
+				Fingerprint:     "fp",
+				Excluded:        true,
+				ExclusionReason: "legacy file",
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	exp := `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="hello.json">
+    <error severity="info" message="[excluded] description is required (reason: legacy file)" source="description is required" fingerprint="fp" excluded="true" exclusion_reason="legacy file"></error>
+  </file>
+</checkstyle>
+`
+	if diff := cmp.Diff(exp, stdout.String()); diff != "" {
+		t.Fatal(diff)
+	}
+}
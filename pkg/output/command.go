@@ -0,0 +1,96 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	gojsonnet "github.com/google/go-jsonnet"
+	"github.com/lintnet/lintnet/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
+)
+
+// commandOutputter passes the result to an external command's standard input as JSON.
+// The command's standard output is outputted as is.
+// The command's standard error is included in the error if the command fails.
+type commandOutputter struct {
+	stdout      io.Writer
+	output      *config.Output
+	transformer *transformer
+	cfgDir      string
+}
+
+func newCommandOutputter(fs afero.Fs, stdout io.Writer, output *config.Output, importer gojsonnet.Importer, cfgDir string) (*commandOutputter, error) {
+	if output.Command == nil || len(output.Command.Args) == 0 {
+		return nil, errors.New("command is required if the renderer is command")
+	}
+	if output.Command.Timeout < 0 {
+		return nil, errors.New("timeout of command must not be negative")
+	}
+	tf, err := newTransformer(fs, output.Transform, importer)
+	if err != nil {
+		return nil, err
+	}
+	return &commandOutputter{
+		stdout:      stdout,
+		output:      output,
+		transformer: tf,
+		cfgDir:      cfgDir,
+	}, nil
+}
+
+func (o *commandOutputter) Output(result *Output) error {
+	r := *result
+	r.Config = o.output.Config
+	b, err := json.Marshal(&r)
+	if err != nil {
+		return fmt.Errorf("marshal output as JSON: %w", err)
+	}
+	s, err := o.transformer.Transform(string(b))
+	if err != nil {
+		return err
+	}
+	return o.run(s)
+}
+
+func (o *commandOutputter) run(input string) error {
+	cmd := o.output.Command
+	ctx := context.Background()
+	if cmd.Timeout > 0 {
+		c, cancel := context.WithTimeout(ctx, time.Duration(cmd.Timeout)*time.Second)
+		defer cancel()
+		ctx = c
+	}
+	c := exec.CommandContext(ctx, cmd.Args[0], cmd.Args[1:]...) //nolint:gosec
+	c.Dir = o.cfgDir
+	c.Env = os.Environ()
+	for k, v := range cmd.Env {
+		c.Env = append(c.Env, k+"="+v)
+	}
+	c.Stdin = strings.NewReader(input)
+	c.Stdout = o.stdout
+	stderr := &bytes.Buffer{}
+	c.Stderr = stderr
+	if err := c.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("command timed out: %w", ctx.Err())
+		}
+		errStderr := strings.TrimSpace(stderr.String())
+		if errStderr != "" {
+			err = fmt.Errorf("%w: %s", err, errStderr)
+		}
+		return fmt.Errorf("run a command: %w", slogerr.With(err,
+			"command", strings.Join(cmd.Args, " "),
+			"stderr", errStderr,
+		))
+	}
+	return nil
+}
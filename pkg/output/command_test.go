@@ -0,0 +1,111 @@
+package output_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/go-jsonnet"
+	"github.com/lintnet/lintnet/pkg/config"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
+)
+
+func TestCommand(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	data := []struct {
+		name    string
+		command *config.Command
+		exp     string
+		stderr  string
+		isErr   bool
+	}{
+		{
+			name: "stdin",
+			command: &config.Command{
+				Args: []string{"sh", "-c", `grep -o '"name":"[^"]*"'`},
+			},
+			exp: `"name":"description is required"` + "\n",
+		},
+		{
+			name: "env",
+			command: &config.Command{
+				Args: []string{"sh", "-c", `echo "$LINTNET_TEST_CHANNEL"`},
+				Env: map[string]string{
+					"LINTNET_TEST_CHANNEL": "lint",
+				},
+			},
+			exp: "lint\n",
+		},
+		{
+			name: "failure",
+			command: &config.Command{
+				Args: []string{"sh", "-c", "echo 'failed to post' >&2; exit 1"},
+			},
+			stderr: "failed to post",
+			isErr:  true,
+		},
+		{
+			name: "timeout",
+			command: &config.Command{
+				Args:    []string{"sleep", "10"},
+				Timeout: 1,
+			},
+			isErr: true,
+		},
+		{
+			name:    "no command",
+			command: &config.Command{},
+			isErr:   true,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			stdout := &bytes.Buffer{}
+			out := &config.Output{
+				ID:       "command",
+				Renderer: "command",
+				Command:  d.command,
+			}
+			getter := output.NewGetter(stdout, afero.NewMemMapFs(), &jsonnet.MemoryImporter{})
+			outputter, err := getter.Get(config.Outputs{out}, &output.ParamGet{
+				Output: out.ID,
+			}, t.TempDir())
+			if err == nil {
+				err = outputter.Output(&output.Output{
+					Errors: []*domain.Error{
+						{
+							Name:     "description is required",
+							LintFile: "hello.jsonnet",
+							DataFile: "hello.json",
+						},
+					},
+				})
+			}
+			if err != nil {
+				if !d.isErr {
+					t.Fatal(err)
+				}
+				if !strings.Contains(err.Error(), d.stderr) {
+					t.Fatalf("the error message must contain the stderr %q: %s", d.stderr, err.Error())
+				}
+				buf := &bytes.Buffer{}
+				slogerr.WithError(slog.New(slog.NewTextHandler(buf, nil)), err).Error("output errors")
+				if !strings.Contains(buf.String(), d.stderr) {
+					t.Fatalf("the log must contain the stderr %q: %s", d.stderr, buf.String())
+				}
+				return
+			}
+			if d.isErr {
+				t.Fatal("error must be returned")
+			}
+			if stdout.String() != d.exp {
+				t.Fatalf("wanted %q, got %q", d.exp, stdout.String())
+			}
+		})
+	}
+}
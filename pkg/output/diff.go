@@ -0,0 +1,66 @@
+package output
+
+import (
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// Diff is the comparison of two lint results.
+// New errors are set to Output.Errors so that built-in formats report only new errors.
+type Diff struct {
+	NumNew       int             `json:"num_new"`
+	NumFixed     int             `json:"num_fixed"`
+	NumUnchanged int             `json:"num_unchanged"`
+	Fixed        []*domain.Error `json:"fixed,omitempty"`
+	Unchanged    []*domain.Error `json:"unchanged,omitempty"`
+}
+
+// Compare compares an old lint result with a new lint result.
+// Errors are matched by fingerprints.
+// If errors have the same fingerprint, they are matched one by one.
+// The returned output has new errors as Errors, and the summary is recalculated from new errors.
+// Counts of lint files, data files, and evaluation errors and the elapsed time are taken from the new result.
+func Compare(oldResult, newResult *Output) *Output {
+	oldErrs := map[string][]*domain.Error{}
+	for _, e := range oldResult.Errors {
+		fp := errorFingerprint(e)
+		oldErrs[fp] = append(oldErrs[fp], e)
+	}
+	diff := &Diff{}
+	newErrs := []*domain.Error{}
+	for _, e := range newResult.Errors {
+		fp := errorFingerprint(e)
+		if errs := oldErrs[fp]; len(errs) > 0 {
+			oldErrs[fp] = errs[1:]
+			diff.Unchanged = append(diff.Unchanged, e)
+			continue
+		}
+		newErrs = append(newErrs, e)
+	}
+	// Keep the order of the old result.
+	for _, e := range oldResult.Errors {
+		fp := errorFingerprint(e)
+		if errs := oldErrs[fp]; len(errs) > 0 && errs[0] == e {
+			oldErrs[fp] = errs[1:]
+			diff.Fixed = append(diff.Fixed, e)
+		}
+	}
+	diff.NumNew = len(newErrs)
+	diff.NumFixed = len(diff.Fixed)
+	diff.NumUnchanged = len(diff.Unchanged)
+
+	summary := NewSummary(nil, newErrs, 0)
+	if s := newResult.Summary; s != nil {
+		summary.NumLintFiles = s.NumLintFiles
+		summary.NumDataFiles = s.NumDataFiles
+		summary.NumEvaluationErrors = s.NumEvaluationErrors
+		summary.NumDataFileErrors = s.NumDataFileErrors
+		summary.ElapsedSeconds = s.ElapsedSeconds
+	}
+	return &Output{
+		LintnetVersion: newResult.LintnetVersion,
+		Env:            newResult.Env,
+		Errors:         newErrs,
+		Summary:        summary,
+		Diff:           diff,
+	}
+}
@@ -0,0 +1,77 @@
+package output_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+)
+
+func TestCompare(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	oldResult := &output.Output{
+		Errors: []*domain.Error{
+			{Name: "a", LintFile: "a.jsonnet", DataFile: "foo.json"},
+			{Name: "b", LintFile: "a.jsonnet", DataFile: "foo.json"},
+			{Name: "b", LintFile: "a.jsonnet", DataFile: "foo.json"},
+			{
+				Name:     "c",
+				LintFile: "github_archive/github.com/lintnet/modules/0ed62adf055a4fbd7ef7ebe304f01794508ed325/modules/c/main.jsonnet:v0.1.0",
+				DataFile: "bar.json",
+			},
+		},
+	}
+	newResult := &output.Output{
+		LintnetVersion: "v0.3.0",
+		Env:            "linux/amd64",
+		Errors: []*domain.Error{
+			{Name: "b", LintFile: "a.jsonnet", DataFile: "foo.json"},
+			{
+				Name:     "c",
+				LintFile: "github_archive/github.com/lintnet/modules/9d7c4b4e4e0e7f3e86b2b9a8fa5ea4e1d5ef0c76/modules/c/main.jsonnet:v0.2.0",
+				DataFile: "bar.json",
+			},
+			{Name: "d", Level: "warn", LintFile: "a.jsonnet", DataFile: "foo.json"},
+		},
+		Summary: &output.Summary{
+			NumLintFiles:   2,
+			NumDataFiles:   2,
+			ElapsedSeconds: 1.5,
+		},
+	}
+	exp := &output.Output{
+		LintnetVersion: "v0.3.0",
+		Env:            "linux/amd64",
+		Errors: []*domain.Error{
+			{Name: "d", Level: "warn", LintFile: "a.jsonnet", DataFile: "foo.json"},
+		},
+		Summary: &output.Summary{
+			NumErrors:      1,
+			NumLintFiles:   2,
+			NumDataFiles:   2,
+			ElapsedSeconds: 1.5,
+			Levels:         map[string]int{"warn": 1},
+			Rules:          map[string]int{"d": 1},
+			LintFiles:      map[string]int{"a.jsonnet": 1},
+			Targets:        map[string]int{},
+			DataFiles:      map[string]int{"foo.json": 1},
+		},
+		Diff: &output.Diff{
+			NumNew:       1,
+			NumFixed:     2,
+			NumUnchanged: 2,
+			Fixed: []*domain.Error{
+				{Name: "a", LintFile: "a.jsonnet", DataFile: "foo.json"},
+				{Name: "b", LintFile: "a.jsonnet", DataFile: "foo.json"},
+			},
+			Unchanged: []*domain.Error{
+				newResult.Errors[0],
+				newResult.Errors[1],
+			},
+		},
+	}
+	if diff := cmp.Diff(exp, output.Compare(oldResult, newResult)); diff != "" {
+		t.Fatal(diff)
+	}
+}
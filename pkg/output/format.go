@@ -12,9 +12,16 @@ type Output struct {
 	LintnetVersion string          `json:"lintnet_version"`
 	Env            string          `json:"env"`
 	Errors         []*domain.Error `json:"errors,omitempty"`
+	Summary        *Summary        `json:"summary,omitempty"`
+	Diff           *Diff           `json:"diff,omitempty"`
 	Config         map[string]any  `json:"config,omitempty"`
+	// Excluded is errors excluded by lint files.
+	// This is set only if -show-excluded is set.
+	Excluded []*domain.Error `json:"excluded,omitempty"`
 }
 
+// FormatResults returns errors whose error levels are higher than or equal to errLevel.
+// Errors of skipped data files are returned regardless of errLevel so that data files aren't skipped silently.
 func FormatResults(logger *slog.Logger, results []*domain.Result, errLevel errlevel.Level) []*domain.Error {
 	list := make([]*domain.Error, 0, len(results))
 	for _, result := range results {
@@ -30,10 +37,20 @@ func FormatResults(logger *slog.Logger, results []*domain.Result, errLevel errle
 					el = e
 				}
 			}
-			if invalid || el >= errLevel {
+			if invalid || result.Skipped || el >= errLevel {
 				list = append(list, fe)
 			}
 		}
 	}
 	return list
 }
+
+// FormatExcludedResults returns errors excluded by lint files.
+// Excluded errors aren't filtered by the error level because they are outputted to audit exclusions.
+func FormatExcludedResults(results []*domain.Result) []*domain.Error {
+	var list []*domain.Error
+	for _, result := range results {
+		list = append(list, result.ExcludedErrors()...)
+	}
+	return list
+}
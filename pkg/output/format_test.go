@@ -38,9 +38,89 @@ func TestFormatResults(t *testing.T) {
 			errLevel: errlevel.Error,
 			exp: []*domain.Error{
 				{
-					Name:     "description is required",
+					Name:        "description is required",
+					LintFile:    "hello.jsonnet",
+					DataFile:    "hello.json",
+					Fingerprint: "c9f0cb046329bd5d40f2dcb9c3aa3e955d8831c65c9d5de75479e46386fdc63f",
+				},
+			},
+		},
+		{
+			name: "combine",
+			results: []*domain.Result{
+				{
+					LintFile:  "hello_combine.jsonnet",
+					DataFiles: []string{"a.yaml", "b.yaml"},
+					RawResult: []*domain.JsonnetResult{
+						{
+							Name:     "name must be unique",
+							DataFile: "a.yaml",
+							Related: []*domain.RelatedLocation{
+								{
+									DataFile: "b.yaml",
+									Message:  "the name is also defined here",
+								},
+							},
+							Fingerprint: "name-must-be-unique",
+						},
+					},
+				},
+			},
+			errLevel: errlevel.Error,
+			exp: []*domain.Error{
+				{
+					Name:     "name must be unique",
+					LintFile: "hello_combine.jsonnet",
+					DataFile: "a.yaml",
+					Related: []*domain.RelatedLocation{
+						{
+							DataFile: "b.yaml",
+							Message:  "the name is also defined here",
+						},
+					},
+					Fingerprint: "name-must-be-unique",
+				},
+			},
+		},
+		{
+			name: "override fingerprint",
+			results: []*domain.Result{
+				{
 					LintFile: "hello.jsonnet",
 					DataFile: "hello.json",
+					RawResult: []*domain.JsonnetResult{
+						{
+							Name:        "description is required",
+							Fingerprint: "description-hello",
+						},
+					},
+				},
+			},
+			errLevel: errlevel.Error,
+			exp: []*domain.Error{
+				{
+					Name:        "description is required",
+					LintFile:    "hello.jsonnet",
+					DataFile:    "hello.json",
+					Fingerprint: "description-hello",
+				},
+			},
+		},
+		{
+			name: "skipped data file",
+			results: []*domain.Result{
+				domain.SkippedResult("big.json", &domain.SkipError{
+					Reason: "the file size 200 exceeds the max size 100",
+				}),
+			},
+			errLevel: errlevel.Error,
+			exp: []*domain.Error{
+				{
+					Name:        "data file is skipped",
+					Level:       "warn",
+					Message:     "the data file is skipped: the file size 200 exceeds the max size 100",
+					DataFile:    "big.json",
+					Fingerprint: "797f4496485222708e60e43c3fe4f7296b6925c2eeb2ea696ba3cd36f4a32d10",
 				},
 			},
 		},
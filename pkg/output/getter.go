@@ -34,17 +34,56 @@ type Outputter interface {
 type ParamGet struct {
 	RootDir string
 	Output  string
+	// Format is a built-in output format.
+	// Format and Output are exclusive.
+	Format string
 }
 
-// setTransform set output.Transform.
-func setTransform(output *config.Output, param *ParamGet, cfgDir string) {
-	if output.TransformModule != nil {
-		output.Transform = filepath.Join(param.RootDir, output.TransformModule.FilePath())
-		return
+// getBuiltinOutputter returns an outputter of a built-in format.
+// If the format is unknown, nil is returned.
+func (g *Getter) getBuiltinOutputter(format, cfgDir string) (Outputter, error) {
+	switch format {
+	case "json":
+		return &jsonOutputter{
+			stdout: g.stdout,
+		}, nil
+	case "checkstyle":
+		return &checkstyleOutputter{
+			stdout: g.stdout,
+		}, nil
+	case "rdjson":
+		return &rdjsonOutputter{
+			stdout: g.stdout,
+		}, nil
+	case "rdjsonl":
+		return &rdjsonOutputter{
+			stdout: g.stdout,
+			lines:  true,
+		}, nil
+	case "gitlab-codequality":
+		return &gitlabOutputter{
+			stdout: g.stdout,
+		}, nil
+	case "ndjson":
+		return newNDJSONOutputter(g.stdout), nil
+	case "html-report":
+		return newHTMLReportOutputter(g.stdout, g.fs, cfgDir)
 	}
-	output.Transform = filepath.FromSlash(output.Transform)
-	if !filepath.IsAbs(output.Transform) {
-		output.Transform = filepath.Join(cfgDir, output.Transform)
+	return nil, nil //nolint:nilnil
+}
+
+// setTransform converts output.Transform to file paths.
+func setTransform(output *config.Output, param *ParamGet, cfgDir string) {
+	for i, transform := range output.Transform {
+		if i < len(output.TransformModules) && output.TransformModules[i] != nil {
+			output.Transform[i] = filepath.Join(param.RootDir, output.TransformModules[i].FilePath())
+			continue
+		}
+		transform = filepath.FromSlash(transform)
+		if !filepath.IsAbs(transform) {
+			transform = filepath.Join(cfgDir, transform)
+		}
+		output.Transform[i] = transform
 	}
 }
 
@@ -61,6 +100,19 @@ func setTemplate(output *config.Output, param *ParamGet, cfgDir string) {
 
 // Get returns an outputter.
 func (g *Getter) Get(outputs config.Outputs, param *ParamGet, cfgDir string) (Outputter, error) {
+	if param.Format != "" {
+		if param.Output != "" {
+			return nil, errors.New("output and format can't be specified at the same time")
+		}
+		outputter, err := g.getBuiltinOutputter(param.Format, cfgDir)
+		if err != nil {
+			return nil, err
+		}
+		if outputter == nil {
+			return nil, errors.New("unknown output format")
+		}
+		return outputter, nil
+	}
 	if param.Output == "" {
 		return &jsonOutputter{
 			stdout: g.stdout,
@@ -71,15 +123,21 @@ func (g *Getter) Get(outputs config.Outputs, param *ParamGet, cfgDir string) (Ou
 		return nil, errors.New("unknown output id")
 	}
 
+	if outputter, err := g.getBuiltinOutputter(output.Renderer, cfgDir); err != nil || outputter != nil {
+		return outputter, err
+	}
+
 	if output.Template != "" {
 		setTemplate(output, param, cfgDir)
 	}
 
-	if output.Transform != "" {
+	if len(output.Transform) > 0 {
 		setTransform(output, param, cfgDir)
 	}
 
 	switch output.Renderer {
+	case "command":
+		return newCommandOutputter(g.fs, g.stdout, output, g.importer, cfgDir)
 	case "jsonnet":
 		return newJsonnetOutputter(g.fs, g.stdout, output, g.importer)
 	case "text/template":
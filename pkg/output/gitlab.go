@@ -0,0 +1,68 @@
+package output
+
+import (
+	"io"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// gitlabOutputter outputs results in GitLab Code Quality report format.
+// https://docs.gitlab.com/ci/testing/code_quality/#code-quality-report-format
+type gitlabOutputter struct {
+	stdout io.Writer
+}
+
+type gitlabIssue struct {
+	Description string          `json:"description"`
+	CheckName   string          `json:"check_name"`
+	Fingerprint string          `json:"fingerprint"`
+	Severity    string          `json:"severity"`
+	Location    *gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+	Path  string       `json:"path"`
+	Lines *gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+}
+
+var gitlabSeverities = map[severity]string{ //nolint:gochecknoglobals
+	severityInfo:    "info",
+	severityWarning: "minor",
+	severityError:   "major",
+}
+
+func (o *gitlabOutputter) Output(result *Output) error {
+	issues := make([]*gitlabIssue, 0, len(result.Errors)+len(result.Excluded))
+	for _, e := range result.Errors {
+		issues = append(issues, newGitLabIssue(e, errorMessage(e), gitlabSeverities[getSeverity(e.Level)]))
+	}
+	// GitLab Code Quality has no field for exclusions, so excluded errors are outputted at the info severity with the marker in the description.
+	for _, e := range result.Excluded {
+		issues = append(issues, newGitLabIssue(e, excludedMessage(e), gitlabSeverities[severityInfo]))
+	}
+	return outputJSON(o.stdout, issues)
+}
+
+func newGitLabIssue(e *domain.Error, description, severity string) *gitlabIssue {
+	// lines.begin is required, so if the line is unknown the first line is used.
+	line := 1
+	if rng := parseLocation(e.Location); rng != nil {
+		line = rng.Start.Line
+	}
+	return &gitlabIssue{
+		Description: description,
+		CheckName:   e.Name,
+		Fingerprint: errorFingerprint(e),
+		Severity:    severity,
+		Location: &gitlabLocation{
+			Path: errorPath(e),
+			Lines: &gitlabLines{
+				Begin: line,
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package output_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+)
+
+func TestGitLabCodeQuality(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	outputGitLab := func(errs []*domain.Error) []map[string]any {
+		t.Helper()
+		stdout := &bytes.Buffer{}
+		getter := output.NewGetter(stdout, afero.NewMemMapFs(), nil)
+		outputter, err := getter.Get(nil, &output.ParamGet{
+			Format: "gitlab-codequality",
+		}, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := outputter.Output(&output.Output{
+			Errors: errs,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		var issues []map[string]any
+		if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+			t.Fatal(err)
+		}
+		return issues
+	}
+
+	issues := outputGitLab([]*domain.Error{
+		{
+			Name:     "description is required",
+			LintFile: "github_archive/github.com/lintnet-modules/ghalint/00571db321e413d45be457f39e48cd4237399bb7/workflow/main.jsonnet:v0.3.0",
+			DataFile: "hello.json",
+			Location: map[string]any{
+				"line": float64(5),
+			},
+		},
+		{
+			Name:     "name is too long",
+			Message:  "name must be shorter than 10 characters",
+			Level:    "warn",
+			LintFile: "hello.jsonnet",
+			DataFile: "hello.json",
+		},
+	})
+	if len(issues) != 2 { //nolint:mnd
+		t.Fatalf("the number of issues must be 2: %d", len(issues))
+	}
+	for _, issue := range issues {
+		if issue["fingerprint"] == "" {
+			t.Fatal("fingerprint must be set")
+		}
+		delete(issue, "fingerprint")
+	}
+	exp := []map[string]any{
+		{
+			"description": "description is required",
+			"check_name":  "description is required",
+			"severity":    "major",
+			"location": map[string]any{
+				"path": "hello.json",
+				"lines": map[string]any{
+					"begin": float64(5),
+				},
+			},
+		},
+		{
+			"description": "name must be shorter than 10 characters",
+			"check_name":  "name is too long",
+			"severity":    "minor",
+			"location": map[string]any{
+				"path": "hello.json",
+				"lines": map[string]any{
+					"begin": float64(1),
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(exp, issues); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// The fingerprint must be stable even if the module version is changed.
+	e := &domain.Error{
+		Name:     "description is required",
+		DataFile: "hello.json",
+	}
+	e1 := *e
+	e1.LintFile = "github_archive/github.com/lintnet-modules/ghalint/00571db321e413d45be457f39e48cd4237399bb7/workflow/main.jsonnet:v0.3.0"
+	e2 := *e
+	e2.LintFile = "github_archive/github.com/lintnet-modules/ghalint/0ed62adf055a4fbd7ef7ebe304f01794508ed325/workflow/main.jsonnet:v0.4.0"
+	issues = outputGitLab([]*domain.Error{&e1, &e2})
+	if issues[0]["fingerprint"] != issues[1]["fingerprint"] {
+		t.Fatalf("fingerprints must be same: %v, %v", issues[0]["fingerprint"], issues[1]["fingerprint"])
+	}
+}
+
+func TestGitLabCodeQuality_excluded(t *testing.T) {
+	t.Parallel()
+	stdout := &bytes.Buffer{}
+	getter := output.NewGetter(stdout, afero.NewMemMapFs(), nil)
+	outputter, err := getter.Get(nil, &output.ParamGet{
+		Format: "gitlab-codequality",
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := outputter.Output(&output.Output{
+		Excluded: []*domain.Error{
+			{
+				Name:            "description is required",
+				LintFile:        "hello.jsonnet",
+				DataFile:        "hello.json",
+				Fingerprint:     "fp",
+				Excluded:        true,
+				ExclusionReason: "legacy file",
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var issues []map[string]any
+	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+		t.Fatal(err)
+	}
+	exp := []map[string]any{
+		{
+			"description": "[excluded] description is required (reason: legacy file)",
+			"check_name":  "description is required",
+			"fingerprint": "fp",
+			"severity":    "info",
+			"location": map[string]any{
+				"path": "hello.json",
+				"lines": map[string]any{
+					"begin": float64(1),
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(exp, issues); diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -0,0 +1,195 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"slices"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/render"
+	"github.com/spf13/afero"
+)
+
+//go:embed html_report.html
+var htmlReportTemplate []byte
+
+// snippetContext is the number of lines shown before and after the location.
+const snippetContext = 3
+
+// htmlReportOutputter outputs a self-contained HTML report.
+// The report is rendered by the html/template renderer with the built-in template.
+type htmlReportOutputter struct {
+	stdout   io.Writer
+	fs       afero.Fs
+	cfgDir   string
+	template render.Template
+}
+
+type htmlReport struct {
+	*Output
+
+	Errors []*htmlReportError
+	// Excluded is errors excluded by lint files.
+	// They are shown in a separate section.
+	Excluded []*htmlReportError
+	Levels   []string
+	Rules    []string
+	Targets  []string
+	Files    []string
+}
+
+type htmlReportError struct {
+	*domain.Error
+
+	Level string
+	Path  string
+	// Fingerprint is computed if the error doesn't have a fingerprint.
+	Fingerprint string
+	Line        int
+	Snippet     []*snippetLine
+	Related     []*htmlReportRelated
+}
+
+type htmlReportRelated struct {
+	*domain.RelatedLocation
+
+	Line int
+}
+
+type snippetLine struct {
+	Number      int
+	Text        string
+	Highlighted bool
+}
+
+func newHTMLReportOutputter(stdout io.Writer, fs afero.Fs, cfgDir string) (*htmlReportOutputter, error) {
+	tpl, err := (&render.HTMLTemplateRenderer{}).Compile(string(htmlReportTemplate), nil)
+	if err != nil {
+		return nil, fmt.Errorf("parse the template of HTML report: %w", err)
+	}
+	return &htmlReportOutputter{
+		stdout:   stdout,
+		fs:       fs,
+		cfgDir:   cfgDir,
+		template: tpl,
+	}, nil
+}
+
+func (o *htmlReportOutputter) Output(result *Output) error {
+	report := &htmlReport{
+		Output:   result,
+		Errors:   make([]*htmlReportError, len(result.Errors)),
+		Excluded: make([]*htmlReportError, len(result.Excluded)),
+	}
+	levels := map[string]struct{}{}
+	rules := map[string]struct{}{}
+	targets := map[string]struct{}{}
+	files := map[string]struct{}{}
+	fileLines := map[string][]string{}
+	add := func(e *domain.Error) *htmlReportError {
+		re := o.newError(fileLines, e)
+		levels[re.Level] = struct{}{}
+		addKey(rules, e.Name)
+		addKey(targets, e.TargetID)
+		addKey(files, re.Path)
+		return re
+	}
+	for i, e := range result.Errors {
+		report.Errors[i] = add(e)
+	}
+	for i, e := range result.Excluded {
+		report.Excluded[i] = add(e)
+	}
+	report.Levels = slices.Sorted(maps.Keys(levels))
+	report.Rules = slices.Sorted(maps.Keys(rules))
+	report.Targets = slices.Sorted(maps.Keys(targets))
+	report.Files = slices.Sorted(maps.Keys(files))
+	if err := o.template.Execute(o.stdout, report); err != nil {
+		return fmt.Errorf("render the HTML report: %w", err)
+	}
+	return nil
+}
+
+func (o *htmlReportOutputter) newError(fileLines map[string][]string, e *domain.Error) *htmlReportError {
+	re := &htmlReportError{
+		Error:       e,
+		Level:       levelName(e.Level),
+		Path:        errorPath(e),
+		Fingerprint: errorFingerprint(e),
+	}
+	if rng := parseLocation(e.Location); rng != nil {
+		re.Line = rng.Start.Line
+		if e.DataFile != "" {
+			re.Snippet = o.snippet(fileLines, e.DataFile, rng)
+		}
+	}
+	for _, related := range e.Related {
+		rr := &htmlReportRelated{
+			RelatedLocation: related,
+		}
+		if rng := parseLocation(related.Location); rng != nil {
+			rr.Line = rng.Start.Line
+		}
+		re.Related = append(re.Related, rr)
+	}
+	return re
+}
+
+func addKey(m map[string]struct{}, key string) {
+	if key == "" {
+		return
+	}
+	m[key] = struct{}{}
+}
+
+// snippet returns lines around the location.
+// If it fails to read the data file, it returns nil because snippets are optional.
+// fileLines is a cache of data files' lines.
+func (o *htmlReportOutputter) snippet(fileLines map[string][]string, dataFile string, rng *Range) []*snippetLine {
+	lines, ok := fileLines[dataFile]
+	if !ok {
+		lines = o.readLines(dataFile)
+		fileLines[dataFile] = lines
+	}
+	endLine := rng.Start.Line
+	if rng.End != nil && rng.End.Line > endLine {
+		endLine = rng.End.Line
+	}
+	first := max(rng.Start.Line-snippetContext, 1)
+	last := min(endLine+snippetContext, len(lines))
+	if first > last {
+		return nil
+	}
+	snippet := make([]*snippetLine, 0, last-first+1)
+	for n := first; n <= last; n++ {
+		snippet = append(snippet, &snippetLine{
+			Number:      n,
+			Text:        lines[n-1],
+			Highlighted: n >= rng.Start.Line && n <= endLine,
+		})
+	}
+	return snippet
+}
+
+func (o *htmlReportOutputter) readLines(dataFile string) []string {
+	p := filepath.FromSlash(dataFile)
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(o.cfgDir, p)
+	}
+	b, err := afero.ReadFile(o.fs, p)
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	scanner.Buffer(nil, len(b)+1)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
@@ -0,0 +1,110 @@
+package output_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+)
+
+func TestHTMLReport(t *testing.T) {
+	t.Parallel()
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, "/workspace/hello.yaml", []byte("a: 1\nb: 2\nc: <3>\nd: 4\ne: 5\nf: 6\ng: 7\nh: 8\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stdout := &bytes.Buffer{}
+	getter := output.NewGetter(stdout, fs, nil)
+	outputter, err := getter.Get(nil, &output.ParamGet{
+		Format: "html-report",
+	}, "/workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := outputter.Output(&output.Output{
+		LintnetVersion: "v0.3.0",
+		Errors: []*domain.Error{
+			{
+				Name:     "c must be a number",
+				TargetID: "yaml",
+				LintFile: "hello.jsonnet",
+				DataFile: "hello.yaml",
+				Links: []*domain.Link{
+					{
+						Title: "rule",
+						Link:  "https://example.com/rule",
+					},
+				},
+				Location: map[string]any{
+					"line": float64(3),
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	html := stdout.String()
+	for _, s := range []string{
+		`<div class="error level-error" data-level="error" data-rule="c must be a number" data-target="yaml" data-file="hello.yaml" data-fingerprint="760b6d743a282815386eeb8128a514a6d9ff277f41932a6d40dce86ad5090882">`,
+		`<div class="fingerprint">fingerprint: 760b6d743a282815386eeb8128a514a6d9ff277f41932a6d40dce86ad5090882</div>`,
+		`<a href="https://example.com/rule">rule</a>`,
+		`<tr class="highlighted"><td class="line-number">3</td><td>c: &lt;3&gt;</td></tr>`,
+		`<td class="line-number">6</td>`,
+		`<option>hello.yaml</option>`,
+	} {
+		if !strings.Contains(html, s) {
+			t.Fatalf("the report must include %s: %s", s, html)
+		}
+	}
+	for _, s := range []string{
+		`<td class="line-number">7</td>`,
+		"<script src=",
+		"<link ",
+	} {
+		if strings.Contains(html, s) {
+			t.Fatalf("the report must not include %s", s)
+		}
+	}
+}
+
+func TestHTMLReport_excluded(t *testing.T) {
+	t.Parallel()
+	stdout := &bytes.Buffer{}
+	getter := output.NewGetter(stdout, afero.NewMemMapFs(), nil)
+	outputter, err := getter.Get(nil, &output.ParamGet{
+		Format: "html-report",
+	}, "/workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := outputter.Output(&output.Output{
+		Errors: []*domain.Error{},
+		Excluded: []*domain.Error{
+			{
+				Name:            "description is required",
+				LintFile:        "hello.jsonnet",
+				DataFile:        "hello.json",
+				Fingerprint:     "fp",
+				Excluded:        true,
+				ExclusionReason: "legacy file",
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	html := stdout.String()
+	for _, s := range []string{
+		`<h2>Excluded</h2>`,
+		`<div class="error level-error excluded" data-level="error" data-rule="description is required" data-target="" data-file="hello.json" data-fingerprint="fp">`,
+		`<span class="badge">excluded</span>`,
+		`<p class="exclusion-reason">Exclusion reason: legacy file</p>`,
+		`<span id="count">0 errors</span>`,
+	} {
+		if !strings.Contains(html, s) {
+			t.Fatalf("the report must include %s: %s", s, html)
+		}
+	}
+}
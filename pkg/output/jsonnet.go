@@ -12,12 +12,12 @@ import (
 )
 
 type jsonnetOutputter struct {
-	stdout    io.Writer
-	output    *config.Output
-	transform jsonnet.Node
-	node      jsonnet.Node
-	importer  gojsonnet.Importer
-	config    map[string]any
+	stdout      io.Writer
+	output      *config.Output
+	transformer *transformer
+	node        jsonnet.Node
+	importer    gojsonnet.Importer
+	config      map[string]any
 }
 
 func newJsonnetOutputter(fs afero.Fs, stdout io.Writer, output *config.Output, importer gojsonnet.Importer) (*jsonnetOutputter, error) {
@@ -25,21 +25,18 @@ func newJsonnetOutputter(fs afero.Fs, stdout io.Writer, output *config.Output, i
 	if err != nil {
 		return nil, fmt.Errorf("read a template as Jsonnet: %w", err)
 	}
-	outputter := &jsonnetOutputter{
-		stdout:   stdout,
-		output:   output,
-		node:     node,
-		importer: importer,
-		config:   output.Config,
-	}
-	if output.Transform != "" {
-		node, err := jsonnet.ReadToNode(fs, output.Transform)
-		if err != nil {
-			return nil, fmt.Errorf("read a transform as Jsonnet: %w", err)
-		}
-		outputter.transform = node
+	tf, err := newTransformer(fs, output.Transform, importer)
+	if err != nil {
+		return nil, err
 	}
-	return outputter, nil
+	return &jsonnetOutputter{
+		stdout:      stdout,
+		output:      output,
+		transformer: tf,
+		node:        node,
+		importer:    importer,
+		config:      output.Config,
+	}, nil
 }
 
 func (o *jsonnetOutputter) Output(result *Output) error {
@@ -49,20 +46,22 @@ func (o *jsonnetOutputter) Output(result *Output) error {
 	if err != nil {
 		return fmt.Errorf("marshal output as JSON: %w", err)
 	}
-	tlaS := string(tla)
-	if o.transform != nil {
-		vm := jsonnet.NewVM(string(tla), o.importer)
-		s, err := vm.Evaluate(o.transform)
-		if err != nil {
-			return fmt.Errorf("evaluate a jsonnet: %w", err)
-		}
-		tlaS = s
+	tlaS, err := o.transformer.Transform(string(tla))
+	if err != nil {
+		return err
 	}
 	vm := jsonnet.NewVM(tlaS, o.importer)
+	vm.StringOutput = o.output.StringOutput
 	s, err := vm.Evaluate(o.node)
 	if err != nil {
 		return fmt.Errorf("evaluate a jsonnet: %w", err)
 	}
+	if o.output.StringOutput {
+		if _, err := io.WriteString(o.stdout, s); err != nil {
+			return fmt.Errorf("output the result: %w", err)
+		}
+		return nil
+	}
 	var a any
 	if err := json.Unmarshal([]byte(s), &a); err != nil {
 		return fmt.Errorf("unmarshal the result as JSON: %w", err)
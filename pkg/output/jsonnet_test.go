@@ -0,0 +1,121 @@
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-jsonnet"
+	"github.com/lintnet/lintnet/pkg/config"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+)
+
+func TestJsonnet(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	data := []struct {
+		name       string
+		template   string
+		transforms map[string]string
+		output     *config.Output
+		exp        string
+	}{
+		{
+			name: "json",
+			template: `function(param) {
+  count: std.length(param.errors),
+}`,
+			output: &config.Output{
+				ID:       "json",
+				Renderer: "jsonnet",
+			},
+			exp: `{
+  "count": 1
+}
+`,
+		},
+		{
+			name: "string output",
+			template: `function(param) std.join('\n', [
+  '| rule | file |',
+  '| --- | --- |',
+] + [
+  '| %s | %s |' % [e.name, e.data_file]
+  for e in param.errors
+])`,
+			output: &config.Output{
+				ID:           "markdown",
+				Renderer:     "jsonnet",
+				StringOutput: true,
+			},
+			exp: `| rule | file |
+| --- | --- |
+| description is required | hello.json |
+`,
+		},
+		{
+			name: "chained transforms",
+			template: `function(param) {
+  names: [e.name for e in param.errors],
+}`,
+			transforms: map[string]string{
+				"add.jsonnet": `function(param) param + {
+  errors: param.errors + [{name: "name is required"}],
+}`,
+				"upper.jsonnet": `function(param) param + {
+  errors: [e + {name: std.asciiUpper(e.name)} for e in param.errors],
+}`,
+			},
+			output: &config.Output{
+				ID:        "chain",
+				Renderer:  "jsonnet",
+				Transform: config.Transforms{"add.jsonnet", "/workspace/upper.jsonnet"},
+			},
+			exp: `{
+  "names": [
+    "DESCRIPTION IS REQUIRED",
+    "NAME IS REQUIRED"
+  ]
+}
+`,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			fs := afero.NewMemMapFs()
+			if err := afero.WriteFile(fs, "/workspace/output.jsonnet", []byte(d.template), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			for name, transform := range d.transforms {
+				if err := afero.WriteFile(fs, "/workspace/"+name, []byte(transform), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			d.output.Template = "output.jsonnet"
+			stdout := &bytes.Buffer{}
+			getter := output.NewGetter(stdout, fs, &jsonnet.MemoryImporter{})
+			outputter, err := getter.Get(config.Outputs{d.output}, &output.ParamGet{
+				Output: d.output.ID,
+			}, "/workspace")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := outputter.Output(&output.Output{
+				Errors: []*domain.Error{
+					{
+						Name:     "description is required",
+						LintFile: "hello.jsonnet",
+						DataFile: "hello.json",
+					},
+				},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(d.exp, stdout.String()); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package output
+
+import (
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// Position is a position in a file.
+// Line and Column start from 1.
+// 0 means the value is unknown.
+type Position struct {
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+}
+
+// Range is a range in a file.
+type Range struct {
+	Start *Position `json:"start"`
+	End   *Position `json:"end,omitempty"`
+}
+
+// parseLocation gets a range from domain.Error.Location.
+// The format of location is free, but if location is an object having the following number fields, they are used as a range.
+//
+//   - line
+//   - column
+//   - end_line
+//   - end_column
+//
+// If location doesn't have line, parseLocation returns nil.
+func parseLocation(location any) *Range {
+	m, ok := location.(map[string]any)
+	if !ok {
+		return nil
+	}
+	line := getInt(m, "line")
+	if line <= 0 {
+		return nil
+	}
+	rng := &Range{
+		Start: &Position{
+			Line:   line,
+			Column: getInt(m, "column"),
+		},
+	}
+	if endLine := getInt(m, "end_line"); endLine > 0 {
+		rng.End = &Position{
+			Line:   endLine,
+			Column: getInt(m, "end_column"),
+		}
+	}
+	return rng
+}
+
+func getInt(m map[string]any, key string) int {
+	switch v := m[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
+
+// errorPath returns a file path where the error occurs.
+// If the error doesn't have a data file, the lint file is returned.
+func errorPath(e *domain.Error) string {
+	if e.DataFile != "" {
+		return e.DataFile
+	}
+	return e.LintFile
+}
+
+// errorMessage returns a message of the error.
+// If the error doesn't have a message, the rule name is returned.
+func errorMessage(e *domain.Error) string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return e.Name
+}
+
+// excludedMessage returns a message of an error excluded by the lint file.
+// It's used by formats which don't have fields for exclusions, so the marker and the reason are included in the message.
+func excludedMessage(e *domain.Error) string {
+	msg := "[excluded] " + errorMessage(e)
+	if e.ExclusionReason != "" {
+		msg += " (reason: " + e.ExclusionReason + ")"
+	}
+	return msg
+}
+
+// errorLink returns the first link of the error.
+func errorLink(e *domain.Error) string {
+	for _, link := range e.Links {
+		if link.Link != "" {
+			return link.Link
+		}
+	}
+	return ""
+}
+
+// severity is a severity of an error, which is mapped from the error level.
+type severity int
+
+const (
+	severityInfo severity = iota
+	severityWarning
+	severityError
+)
+
+// getSeverity maps the error level to the severity.
+// If the error level is empty or invalid, severityError is returned.
+func getSeverity(level string) severity {
+	switch level {
+	case "debug", "info":
+		return severityInfo
+	case "warn":
+		return severityWarning
+	default:
+		return severityError
+	}
+}
+
+// errorFingerprint returns the fingerprint of the error.
+// Results outputted by old versions of lintnet don't have fingerprints, so the fingerprint is computed if it's empty.
+func errorFingerprint(e *domain.Error) string {
+	if e.Fingerprint != "" {
+		return e.Fingerprint
+	}
+	return domain.Fingerprint(e)
+}
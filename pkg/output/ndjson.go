@@ -0,0 +1,51 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// Streamer is an outputter which outputs errors as soon as lint files are evaluated.
+// Stream is called whenever errors are found, and then Output is called once with the result without errors.
+type Streamer interface {
+	Outputter
+	Stream(errs []*domain.Error) error
+}
+
+// ndjsonOutputter outputs errors in newline delimited JSON.
+// Each line is an error, and the last line is a summary record which doesn't have errors.
+type ndjsonOutputter struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONOutputter(stdout io.Writer) *ndjsonOutputter {
+	encoder := json.NewEncoder(stdout)
+	encoder.SetEscapeHTML(false)
+	return &ndjsonOutputter{
+		encoder: encoder,
+	}
+}
+
+func (o *ndjsonOutputter) Stream(errs []*domain.Error) error {
+	for _, e := range errs {
+		if err := o.encoder.Encode(e); err != nil {
+			return fmt.Errorf("encode an error as JSON: %w", err)
+		}
+	}
+	return nil
+}
+
+func (o *ndjsonOutputter) Output(result *Output) error {
+	if err := o.Stream(result.Errors); err != nil {
+		return err
+	}
+	r := *result
+	r.Errors = nil
+	if err := o.encoder.Encode(&r); err != nil {
+		return fmt.Errorf("encode the summary as JSON: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+)
+
+func TestNDJSON(t *testing.T) {
+	t.Parallel()
+	stdout := &bytes.Buffer{}
+	getter := output.NewGetter(stdout, afero.NewMemMapFs(), nil)
+	outputter, err := getter.Get(nil, &output.ParamGet{
+		Format: "ndjson",
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	streamer, ok := outputter.(output.Streamer)
+	if !ok {
+		t.Fatal("ndjson outputter must be a streamer")
+	}
+	if err := streamer.Stream([]*domain.Error{
+		{
+			Name:     "description is required",
+			LintFile: "hello.jsonnet",
+			DataFile: "foo.json",
+		},
+		{
+			Name:     "description is required",
+			LintFile: "hello.jsonnet",
+			DataFile: "bar.json",
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := streamer.Output(&output.Output{
+		LintnetVersion: "v0.3.0",
+		Summary: &output.Summary{
+			NumErrors: 2,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	exp := `{"name":"description is required","lint_file":"hello.jsonnet","data_file":"foo.json"}
+{"name":"description is required","lint_file":"hello.jsonnet","data_file":"bar.json"}
+{"lintnet_version":"v0.3.0","env":"","summary":{"num_errors":2,"num_lint_files":0,"num_data_files":0,"num_evaluation_errors":0,"num_data_file_errors":0,"elapsed_seconds":0,"levels":null,"rules":null,"lint_files":null,"targets":null,"data_files":null}}
+`
+	if diff := cmp.Diff(exp, stdout.String()); diff != "" {
+		t.Fatal(diff)
+	}
+}
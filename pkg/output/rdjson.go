@@ -0,0 +1,142 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// rdjsonOutputter outputs results in reviewdog's Diagnostic Format.
+// If Lines is true, results are outputted in rdjsonl format.
+// Otherwise, results are outputted in rdjson format.
+// https://github.com/reviewdog/reviewdog/tree/master/proto/rdf
+type rdjsonOutputter struct {
+	stdout io.Writer
+	lines  bool
+}
+
+type rdjsonResult struct {
+	Source      *rdjsonSource       `json:"source"`
+	Diagnostics []*rdjsonDiagnostic `json:"diagnostics"`
+}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonDiagnostic struct {
+	Message  string          `json:"message"`
+	Location *rdjsonLocation `json:"location"`
+	Severity string          `json:"severity,omitempty"`
+	Source   *rdjsonSource   `json:"source,omitempty"`
+	Code     *rdjsonCode     `json:"code,omitempty"`
+	// OriginalOutput is the fingerprint of the error.
+	// rdjson has no field for fingerprints, so the field for the original output of the tool is used.
+	OriginalOutput string `json:"original_output,omitempty"`
+
+	RelatedLocations []*rdjsonRelatedLocation `json:"related_locations,omitempty"`
+}
+
+type rdjsonRelatedLocation struct {
+	Message  string          `json:"message,omitempty"`
+	Location *rdjsonLocation `json:"location"`
+}
+
+type rdjsonLocation struct {
+	Path  string `json:"path"`
+	Range *Range `json:"range,omitempty"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+var rdjsonSeverities = map[severity]string{ //nolint:gochecknoglobals
+	severityInfo:    "INFO",
+	severityWarning: "WARNING",
+	severityError:   "ERROR",
+}
+
+func newRDJSONSource() *rdjsonSource {
+	return &rdjsonSource{
+		Name: "lintnet",
+		URL:  "https://lintnet.github.io/",
+	}
+}
+
+func newRDJSONDiagnostic(e *domain.Error) *rdjsonDiagnostic {
+	diag := &rdjsonDiagnostic{
+		Message: errorMessage(e),
+		Location: &rdjsonLocation{
+			Path:  errorPath(e),
+			Range: parseLocation(e.Location),
+		},
+		Severity:       rdjsonSeverities[getSeverity(e.Level)],
+		OriginalOutput: errorFingerprint(e),
+	}
+	for _, related := range e.Related {
+		diag.RelatedLocations = append(diag.RelatedLocations, &rdjsonRelatedLocation{
+			Message: related.Message,
+			Location: &rdjsonLocation{
+				Path:  related.DataFile,
+				Range: parseLocation(related.Location),
+			},
+		})
+	}
+	if e.Name != "" {
+		diag.Code = &rdjsonCode{
+			Value: e.Name,
+			URL:   errorLink(e),
+		}
+	}
+	return diag
+}
+
+// newRDJSONExcludedDiagnostic returns a diagnostic of an error excluded by the lint file.
+// rdjson has no field for exclusions, so the diagnostic has the info severity and the message includes the marker and the reason.
+func newRDJSONExcludedDiagnostic(e *domain.Error) *rdjsonDiagnostic {
+	diag := newRDJSONDiagnostic(e)
+	diag.Message = excludedMessage(e)
+	diag.Severity = rdjsonSeverities[severityInfo]
+	return diag
+}
+
+// diagnostics returns diagnostics of errors and excluded errors.
+func (o *rdjsonOutputter) diagnostics(result *Output) []*rdjsonDiagnostic {
+	diags := make([]*rdjsonDiagnostic, 0, len(result.Errors)+len(result.Excluded))
+	for _, e := range result.Errors {
+		diags = append(diags, newRDJSONDiagnostic(e))
+	}
+	for _, e := range result.Excluded {
+		diags = append(diags, newRDJSONExcludedDiagnostic(e))
+	}
+	return diags
+}
+
+func (o *rdjsonOutputter) Output(result *Output) error {
+	if o.lines {
+		return o.outputLines(result)
+	}
+	rr := &rdjsonResult{
+		Source:      newRDJSONSource(),
+		Diagnostics: o.diagnostics(result),
+	}
+	return outputJSON(o.stdout, rr)
+}
+
+func (o *rdjsonOutputter) outputLines(result *Output) error {
+	encoder := json.NewEncoder(o.stdout)
+	encoder.SetEscapeHTML(false)
+	source := newRDJSONSource()
+	for _, diag := range o.diagnostics(result) {
+		diag.Source = source
+		if err := encoder.Encode(diag); err != nil {
+			return fmt.Errorf("encode a diagnostic as JSON: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+)
+
+func TestRDJSON(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	errs := []*domain.Error{
+		{
+			Name:     "description is required",
+			LintFile: "hello.jsonnet",
+			DataFile: "hello.json",
+			Links: []*domain.Link{
+				{
+					Link: "https://example.com/description",
+				},
+			},
+			Location: map[string]any{
+				"line":       float64(2),
+				"column":     float64(3),
+				"end_line":   float64(2),
+				"end_column": float64(10),
+			},
+		},
+		{
+			Name:     "name is too long",
+			Level:    "warn",
+			LintFile: "hello.jsonnet",
+			DataFile: "hello.json",
+			Location: "name",
+			Related: []*domain.RelatedLocation{
+				{
+					DataFile: "foo.json",
+					Location: map[string]any{
+						"line": float64(5),
+					},
+					Message: "the name is also defined here",
+				},
+			},
+		},
+	}
+	data := []struct {
+		name     string
+		format   string
+		excluded []*domain.Error
+		exp      string
+	}{
+		{
+			name:   "rdjson",
+			format: "rdjson",
+			exp: `{
+  "source": {
+    "name": "lintnet",
+    "url": "https://lintnet.github.io/"
+  },
+  "diagnostics": [
+    {
+      "message": "description is required",
+      "location": {
+        "path": "hello.json",
+        "range": {
+          "start": {
+            "line": 2,
+            "column": 3
+          },
+          "end": {
+            "line": 2,
+            "column": 10
+          }
+        }
+      },
+      "severity": "ERROR",
+      "code": {
+        "value": "description is required",
+        "url": "https://example.com/description"
+      },
+      "original_output": "c9f0cb046329bd5d40f2dcb9c3aa3e955d8831c65c9d5de75479e46386fdc63f"
+    },
+    {
+      "message": "name is too long",
+      "location": {
+        "path": "hello.json"
+      },
+      "severity": "WARNING",
+      "code": {
+        "value": "name is too long"
+      },
+      "original_output": "ff52775885266cd7750144edd031d2c40b7bbaee92313a56a5c2c85d3e269fac",
+      "related_locations": [
+        {
+          "message": "the name is also defined here",
+          "location": {
+            "path": "foo.json",
+            "range": {
+              "start": {
+                "line": 5
+              }
+            }
+          }
+        }
+      ]
+    }
+  ]
+}
+`,
+		},
+		{
+			name:   "rdjsonl excluded",
+			format: "rdjsonl",
+			excluded: []*domain.Error{
+				{
+					Name:            "description is required",
+					Level:           "error",
+					LintFile:        "hello.jsonnet",
+					DataFile:        "foo.json",
+					Fingerprint:     "fp",
+					Excluded:        true,
+					ExclusionReason: "legacy file",
+				},
+			},
+			exp: `{"message":"description is required","location":{"path":"hello.json","range":{"start":{"line":2,"column":3},"end":{"line":2,"column":10}}},"severity":"ERROR","source":{"name":"lintnet","url":"https://lintnet.github.io/"},"code":{"value":"description is required","url":"https://example.com/description"},"original_output":"c9f0cb046329bd5d40f2dcb9c3aa3e955d8831c65c9d5de75479e46386fdc63f"}
+{"message":"name is too long","location":{"path":"hello.json"},"severity":"WARNING","source":{"name":"lintnet","url":"https://lintnet.github.io/"},"code":{"value":"name is too long"},"original_output":"ff52775885266cd7750144edd031d2c40b7bbaee92313a56a5c2c85d3e269fac","related_locations":[{"message":"the name is also defined here","location":{"path":"foo.json","range":{"start":{"line":5}}}}]}
+{"message":"[excluded] description is required (reason: legacy file)","location":{"path":"foo.json"},"severity":"INFO","source":{"name":"lintnet","url":"https://lintnet.github.io/"},"code":{"value":"description is required"},"original_output":"fp"}
+`,
+		},
+		{
+			name:   "rdjsonl",
+			format: "rdjsonl",
+			exp: `{"message":"description is required","location":{"path":"hello.json","range":{"start":{"line":2,"column":3},"end":{"line":2,"column":10}}},"severity":"ERROR","source":{"name":"lintnet","url":"https://lintnet.github.io/"},"code":{"value":"description is required","url":"https://example.com/description"},"original_output":"c9f0cb046329bd5d40f2dcb9c3aa3e955d8831c65c9d5de75479e46386fdc63f"}
+{"message":"name is too long","location":{"path":"hello.json"},"severity":"WARNING","source":{"name":"lintnet","url":"https://lintnet.github.io/"},"code":{"value":"name is too long"},"original_output":"ff52775885266cd7750144edd031d2c40b7bbaee92313a56a5c2c85d3e269fac","related_locations":[{"message":"the name is also defined here","location":{"path":"foo.json","range":{"start":{"line":5}}}}]}
+`,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			stdout := &bytes.Buffer{}
+			getter := output.NewGetter(stdout, afero.NewMemMapFs(), nil)
+			outputter, err := getter.Get(nil, &output.ParamGet{
+				Format: d.format,
+			}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := outputter.Output(&output.Output{
+				Errors:   errs,
+				Excluded: d.excluded,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(d.exp, stdout.String()); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
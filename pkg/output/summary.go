@@ -0,0 +1,132 @@
+package output
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lintnet/lintnet/pkg/domain"
+)
+
+// Summary is statistics of lint results.
+// Counts are calculated from errors which are outputted.
+type Summary struct {
+	NumErrors           int `json:"num_errors"`
+	NumLintFiles        int `json:"num_lint_files"`
+	NumDataFiles        int `json:"num_data_files"`
+	NumEvaluationErrors int `json:"num_evaluation_errors"`
+	// NumDataFileErrors is the number of data files which failed to be parsed.
+	// They aren't counted as evaluation errors.
+	NumDataFileErrors int            `json:"num_data_file_errors"`
+	ElapsedSeconds    float64        `json:"elapsed_seconds"`
+	Levels            map[string]int `json:"levels"`
+	Rules             map[string]int `json:"rules"`
+	LintFiles         map[string]int `json:"lint_files"`
+	// Targets is the number of errors per target.
+	// The key is the target id, or "targets[<index>]" if the target has no id.
+	Targets   map[string]int `json:"targets"`
+	DataFiles map[string]int `json:"data_files"`
+	// Owners is set only if CODEOWNERS is enabled.
+	Owners map[string]int `json:"owners,omitempty"`
+}
+
+// NewSummary creates a summary from lint results and errors.
+// results are used to count lint files, data files, evaluation errors, and errors of data files.
+// errs are used to count errors by level, rule, lint file, target, and data file.
+func NewSummary(results []*domain.Result, errs []*domain.Error, elapsed time.Duration) *Summary {
+	builder := NewSummaryBuilder()
+	builder.Add(results, errs)
+	return builder.Build(elapsed)
+}
+
+// SummaryBuilder builds a summary incrementally.
+// This is used to stream results.
+type SummaryBuilder struct {
+	summary   *Summary
+	lintFiles map[string]struct{}
+	dataFiles map[string]struct{}
+}
+
+func NewSummaryBuilder() *SummaryBuilder {
+	return &SummaryBuilder{
+		summary: &Summary{
+			Levels:    map[string]int{},
+			Rules:     map[string]int{},
+			LintFiles: map[string]int{},
+			Targets:   map[string]int{},
+			DataFiles: map[string]int{},
+		},
+		lintFiles: map[string]struct{}{},
+		dataFiles: map[string]struct{}{},
+	}
+}
+
+// Add adds lint results and errors to the summary.
+func (b *SummaryBuilder) Add(results []*domain.Result, errs []*domain.Error) {
+	summary := b.summary
+	summary.NumErrors += len(errs)
+	for _, result := range results {
+		switch {
+		case result.Error == "":
+		case result.DataFileError:
+			summary.NumDataFileErrors++
+		default:
+			summary.NumEvaluationErrors++
+		}
+		if result.LintFile != "" {
+			b.lintFiles[result.LintFile] = struct{}{}
+		}
+		if result.DataFile != "" {
+			b.dataFiles[result.DataFile] = struct{}{}
+		}
+		for _, dataFile := range result.DataFiles {
+			b.dataFiles[dataFile] = struct{}{}
+		}
+	}
+	for _, e := range errs {
+		summary.Levels[levelName(e.Level)]++
+		countKey(summary.Rules, e.Name)
+		countKey(summary.LintFiles, e.LintFile)
+		countKey(summary.Targets, targetKey(e))
+		countKey(summary.DataFiles, e.DataFile)
+		for _, owner := range e.Owners {
+			if summary.Owners == nil {
+				summary.Owners = map[string]int{}
+			}
+			countKey(summary.Owners, owner)
+		}
+	}
+}
+
+// Build returns the summary.
+func (b *SummaryBuilder) Build(elapsed time.Duration) *Summary {
+	summary := *b.summary
+	summary.NumLintFiles = len(b.lintFiles)
+	summary.NumDataFiles = len(b.dataFiles)
+	summary.ElapsedSeconds = elapsed.Seconds()
+	return &summary
+}
+
+// levelName returns the error level name.
+// If the level is empty, the level is "error".
+func levelName(level string) string {
+	if level == "" {
+		return "error"
+	}
+	return level
+}
+
+// targetKey returns the key of the target of the error.
+// If the target has no id, the key is the index of the target such as "targets[0]".
+func targetKey(e *domain.Error) string {
+	if e.TargetID != "" || e.TargetIndex == nil {
+		return e.TargetID
+	}
+	return fmt.Sprintf("targets[%d]", *e.TargetIndex)
+}
+
+func countKey(m map[string]int, key string) {
+	if key == "" {
+		return
+	}
+	m[key]++
+}
@@ -0,0 +1,161 @@
+package output_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+)
+
+func TestNewSummary(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	data := []struct {
+		name    string
+		results []*domain.Result
+		errs    []*domain.Error
+		elapsed time.Duration
+		exp     *output.Summary
+	}{
+		{
+			name: "normal",
+			results: []*domain.Result{
+				{
+					TargetID: "foo",
+					LintFile: "hello.jsonnet",
+					DataFile: "hello.json",
+				},
+				{
+					TargetID: "foo",
+					LintFile: "hello.jsonnet",
+					DataFile: "bar.json",
+				},
+				{
+					TargetID:  "foo",
+					LintFile:  "hello_combine.jsonnet",
+					DataFiles: []string{"hello.json", "bar.json", "zoo.json"},
+					Error:     "evaluate a lint file as Jsonnet",
+				},
+				{
+					TargetID:      "foo",
+					DataFile:      "broken.json",
+					Error:         "parse a data file",
+					DataFileError: true,
+				},
+			},
+			errs: []*domain.Error{
+				{
+					Name:     "description is required",
+					TargetID: "foo",
+					LintFile: "hello.jsonnet",
+					DataFile: "hello.json",
+				},
+				{
+					Name:     "description is required",
+					Level:    "warn",
+					TargetID: "foo",
+					LintFile: "hello.jsonnet",
+					DataFile: "bar.json",
+				},
+				{
+					TargetID: "foo",
+					LintFile: "hello_combine.jsonnet",
+					Message:  "evaluate a lint file as Jsonnet",
+				},
+				{
+					TargetID: "foo",
+					DataFile: "broken.json",
+					Message:  "parse a data file",
+				},
+			},
+			elapsed: 1500 * time.Millisecond,
+			exp: &output.Summary{
+				NumErrors:           4,
+				NumLintFiles:        2,
+				NumDataFiles:        4,
+				NumEvaluationErrors: 1,
+				NumDataFileErrors:   1,
+				ElapsedSeconds:      1.5,
+				Levels: map[string]int{
+					"error": 3,
+					"warn":  1,
+				},
+				Rules: map[string]int{
+					"description is required": 2,
+				},
+				LintFiles: map[string]int{
+					"hello.jsonnet":         2,
+					"hello_combine.jsonnet": 1,
+				},
+				Targets: map[string]int{
+					"foo": 4,
+				},
+				DataFiles: map[string]int{
+					"hello.json":  1,
+					"bar.json":    1,
+					"broken.json": 1,
+				},
+			},
+		},
+		{
+			// Errors of targets without id are counted by target indexes.
+			name: "targets without id",
+			errs: []*domain.Error{
+				{
+					Name:        "description is required",
+					TargetIndex: ptr(0),
+					LintFile:    "hello.jsonnet",
+					DataFile:    "hello.json",
+				},
+				{
+					Name:        "description is required",
+					TargetIndex: ptr(1),
+					LintFile:    "hello.jsonnet",
+					DataFile:    "bar.json",
+				},
+				{
+					Name:     "description is required",
+					TargetID: "foo",
+					LintFile: "hello.jsonnet",
+					DataFile: "zoo.json",
+				},
+			},
+			exp: &output.Summary{
+				NumErrors: 3,
+				Levels: map[string]int{
+					"error": 3,
+				},
+				Rules: map[string]int{
+					"description is required": 3,
+				},
+				LintFiles: map[string]int{
+					"hello.jsonnet": 3,
+				},
+				Targets: map[string]int{
+					"targets[0]": 1,
+					"targets[1]": 1,
+					"foo":        1,
+				},
+				DataFiles: map[string]int{
+					"hello.json": 1,
+					"bar.json":   1,
+					"zoo.json":   1,
+				},
+			},
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			summary := output.NewSummary(d.results, d.errs, d.elapsed)
+			if diff := cmp.Diff(d.exp, summary); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
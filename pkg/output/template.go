@@ -5,21 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 
 	gojsonnet "github.com/google/go-jsonnet"
 	"github.com/lintnet/lintnet/pkg/config"
-	"github.com/lintnet/lintnet/pkg/jsonnet"
 	"github.com/lintnet/lintnet/pkg/render"
 	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
 )
 
 type templateOutputter struct {
-	stdout   io.Writer
-	fs       afero.Fs
-	output   *config.Output
-	template render.Template
-	node     jsonnet.Node
-	importer gojsonnet.Importer
+	stdout      io.Writer
+	fs          afero.Fs
+	output      *config.Output
+	template    render.Template
+	transformer *transformer
 }
 
 func newTemplateOutputter(stdout io.Writer, fs afero.Fs, renderer render.TemplateRenderer, output *config.Output, importer gojsonnet.Importer) (*templateOutputter, error) {
@@ -30,28 +30,55 @@ func newTemplateOutputter(stdout io.Writer, fs afero.Fs, renderer render.Templat
 	if err != nil {
 		return nil, fmt.Errorf("read a template: %w", err)
 	}
-	tpl, err := renderer.Compile(string(b))
+	partials, err := readPartials(fs, output.Template)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := renderer.Compile(string(b), partials)
 	if err != nil {
 		return nil, fmt.Errorf("parse a template: %w", err)
 	}
-	var node jsonnet.Node
-	if output.Transform != "" {
-		n, err := jsonnet.ReadToNode(fs, output.Transform)
-		if err != nil {
-			return nil, fmt.Errorf("read a transform as Jsonnet: %w", err)
-		}
-		node = n
+	tf, err := newTransformer(fs, output.Transform, importer)
+	if err != nil {
+		return nil, err
 	}
 	return &templateOutputter{
-		stdout:   stdout,
-		fs:       fs,
-		output:   output,
-		template: tpl,
-		importer: importer,
-		node:     node,
+		stdout:      stdout,
+		fs:          fs,
+		output:      output,
+		template:    tpl,
+		transformer: tf,
 	}, nil
 }
 
+// readPartials reads partial templates.
+// Partial templates are files matching "_*.tpl" in the same directory as the template.
+// Other files such as "_notes.md" and editor backups aren't loaded.
+// The name of a partial template is the file name.
+func readPartials(fs afero.Fs, tplPath string) (map[string]string, error) {
+	files, err := afero.Glob(fs, filepath.Join(filepath.Dir(tplPath), "_*.tpl"))
+	if err != nil {
+		return nil, fmt.Errorf("search partial templates: %w", err)
+	}
+	partials := make(map[string]string, len(files))
+	for _, file := range files {
+		if file == filepath.Clean(tplPath) {
+			continue
+		}
+		if f, err := afero.IsDir(fs, file); err != nil {
+			return nil, fmt.Errorf("check if a partial template is a directory: %w", slogerr.With(err, "partial_template", file))
+		} else if f {
+			continue
+		}
+		b, err := afero.ReadFile(fs, file)
+		if err != nil {
+			return nil, fmt.Errorf("read a partial template: %w", slogerr.With(err, "partial_template", file))
+		}
+		partials[filepath.Base(file)] = string(b)
+	}
+	return partials, nil
+}
+
 func (o *templateOutputter) Output(result *Output) error {
 	r := *result
 	r.Config = o.output.Config
@@ -59,20 +86,13 @@ func (o *templateOutputter) Output(result *Output) error {
 	if err != nil {
 		return fmt.Errorf("marshal result as JSON: %w", err)
 	}
+	s, err := o.transformer.Transform(string(b))
+	if err != nil {
+		return err
+	}
 	var param any
-	if o.node != nil {
-		vm := jsonnet.NewVM(string(b), o.importer)
-		s, err := vm.Evaluate(o.node)
-		if err != nil {
-			return fmt.Errorf("evaluate a jsonnet: %w", err)
-		}
-		if err := json.Unmarshal([]byte(s), &param); err != nil {
-			return fmt.Errorf("unmarshal transformed result as JSON: %w", err)
-		}
-	} else {
-		if err := json.Unmarshal(b, &param); err != nil {
-			return fmt.Errorf("unmarshal result as JSON: %w", err)
-		}
+	if err := json.Unmarshal([]byte(s), &param); err != nil {
+		return fmt.Errorf("unmarshal transformed result as JSON: %w", err)
 	}
 	if err := o.template.Execute(o.stdout, param); err != nil {
 		return fmt.Errorf("render a template: %w", err)
@@ -0,0 +1,99 @@
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-jsonnet"
+	"github.com/lintnet/lintnet/pkg/config"
+	"github.com/lintnet/lintnet/pkg/domain"
+	"github.com/lintnet/lintnet/pkg/output"
+	"github.com/spf13/afero"
+)
+
+func TestTemplate(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name     string
+		template string
+		files    map[string]string
+		exp      string
+		isErr    bool
+	}{
+		{
+			name:     "partial",
+			template: `{{range .errors}}{{template "_error.tpl" .}}{{end}}`,
+			files: map[string]string{
+				"_error.tpl": "- {{.name}}\n",
+			},
+			exp: "- description is required\n",
+		},
+		{
+			// Files which don't match _*.tpl aren't loaded, so invalid templates in them don't fail the output.
+			name:     "non partial files",
+			template: `{{len .errors}}`,
+			files: map[string]string{
+				"_notes.md":      "{{ invalid",
+				"_error.tpl~":    "{{ invalid",
+				"_error.tpl.bak": "{{ invalid",
+			},
+			exp: "1",
+		},
+		{
+			name:     "invalid partial",
+			template: `{{len .errors}}`,
+			files: map[string]string{
+				"_error.tpl": "{{ invalid",
+			},
+			isErr: true,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			fs := afero.NewMemMapFs()
+			if err := afero.WriteFile(fs, "/workspace/output.tpl", []byte(d.template), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			for name, file := range d.files {
+				if err := afero.WriteFile(fs, "/workspace/"+name, []byte(file), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			out := &config.Output{
+				ID:       "text",
+				Renderer: "text/template",
+				Template: "output.tpl",
+			}
+			stdout := &bytes.Buffer{}
+			getter := output.NewGetter(stdout, fs, &jsonnet.MemoryImporter{})
+			outputter, err := getter.Get(config.Outputs{out}, &output.ParamGet{
+				Output: out.ID,
+			}, "/workspace")
+			if err != nil {
+				if d.isErr {
+					return
+				}
+				t.Fatal(err)
+			}
+			if d.isErr {
+				t.Fatal("error must be returned")
+			}
+			if err := outputter.Output(&output.Output{
+				Errors: []*domain.Error{
+					{
+						Name:     "description is required",
+						LintFile: "hello.jsonnet",
+						DataFile: "hello.json",
+					},
+				},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(d.exp, stdout.String()); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
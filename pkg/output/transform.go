@@ -0,0 +1,46 @@
+package output
+
+import (
+	"fmt"
+
+	gojsonnet "github.com/google/go-jsonnet"
+	"github.com/lintnet/lintnet/pkg/jsonnet"
+	"github.com/spf13/afero"
+	"github.com/suzuki-shunsuke/slog-error/slogerr"
+)
+
+// transformer transforms lint results with Jsonnet files.
+// Transformation files are applied in order, and each transformation file receives the output of the previous one.
+type transformer struct {
+	nodes    []jsonnet.Node
+	importer gojsonnet.Importer
+}
+
+func newTransformer(fs afero.Fs, transforms []string, importer gojsonnet.Importer) (*transformer, error) {
+	nodes := make([]jsonnet.Node, len(transforms))
+	for i, transform := range transforms {
+		node, err := jsonnet.ReadToNode(fs, transform)
+		if err != nil {
+			return nil, fmt.Errorf("read a transform as Jsonnet: %w", slogerr.With(err, "transform", transform))
+		}
+		nodes[i] = node
+	}
+	return &transformer{
+		nodes:    nodes,
+		importer: importer,
+	}, nil
+}
+
+// Transform transforms a JSON string.
+// If there is no transformation file, the input is returned as is.
+func (t *transformer) Transform(input string) (string, error) {
+	for i, node := range t.nodes {
+		vm := jsonnet.NewVM(input, t.importer)
+		s, err := vm.Evaluate(node)
+		if err != nil {
+			return "", fmt.Errorf("evaluate a jsonnet: %w", slogerr.With(err, "transform_index", i))
+		}
+		input = s
+	}
+	return input, nil
+}
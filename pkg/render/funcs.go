@@ -0,0 +1,278 @@
+package render
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FuncMap returns template functions which are available in both text/template and html/template.
+// Functions are inspired by sprig, and the argument order follows sprig so that functions can be used with pipelines.
+func FuncMap() map[string]any {
+	return map[string]any{
+		// string
+		"lower":      strings.ToLower,
+		"upper":      strings.ToUpper,
+		"trim":       strings.TrimSpace,
+		"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+		"replace":    func(old, n, s string) string { return strings.ReplaceAll(s, old, n) },
+		"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"split":      func(sep, s string) []string { return strings.Split(s, sep) },
+		"join":       join,
+		"truncate":   truncate,
+		"indent":     indent,
+		"nindent":    func(spaces int, s string) string { return "\n" + indent(spaces, s) },
+		"quote":      func(s any) string { return fmt.Sprintf("%q", toString(s)) },
+		"toString":   toString,
+		"default":    defaultValue,
+		// list
+		"list":      func(items ...any) []any { return items },
+		"append":    appendList,
+		"first":     first,
+		"last":      last,
+		"uniq":      uniq,
+		"sortAlpha": sortAlpha,
+		"groupBy":   groupBy,
+		// dict
+		"dict":   dict,
+		"keys":   keys,
+		"get":    func(m map[string]any, key string) any { return m[key] },
+		"hasKey": func(m map[string]any, key string) bool { _, ok := m[key]; return ok },
+		// encoding
+		"toJson":         toJSON,
+		"toPrettyJson":   toPrettyJSON,
+		"toYaml":         toYAML,
+		"markdownEscape": markdownEscape,
+	}
+}
+
+func toString(v any) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func toList(v any) ([]any, error) {
+	switch list := v.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		return list, nil
+	case []string:
+		arr := make([]any, len(list))
+		for i, s := range list {
+			arr[i] = s
+		}
+		return arr, nil
+	default:
+		return nil, fmt.Errorf("a list is required but got %T", v)
+	}
+}
+
+func join(sep string, v any) (string, error) {
+	list, err := toList(v)
+	if err != nil {
+		return "", err
+	}
+	arr := make([]string, len(list))
+	for i, a := range list {
+		arr[i] = toString(a)
+	}
+	return strings.Join(arr, sep), nil
+}
+
+// truncate truncates a string to the given length of characters.
+func truncate(length int, s string) string {
+	runes := []rune(s)
+	if length < 0 || len(runes) <= length {
+		return s
+	}
+	return string(runes[:length])
+}
+
+// indent indents each line of a string with the given number of spaces.
+func indent(spaces int, s string) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+}
+
+// defaultValue returns v if v isn't empty.
+// Otherwise, it returns d.
+func defaultValue(d, v any) any {
+	switch a := v.(type) {
+	case nil:
+		return d
+	case string:
+		if a == "" {
+			return d
+		}
+	case bool:
+		if !a {
+			return d
+		}
+	case []any:
+		if len(a) == 0 {
+			return d
+		}
+	case map[string]any:
+		if len(a) == 0 {
+			return d
+		}
+	}
+	return v
+}
+
+// appendList returns a new list appending an item to a list.
+func appendList(v, item any) ([]any, error) {
+	list, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(slices.Clone(list), item), nil
+}
+
+func first(v any) (any, error) {
+	list, err := toList(v)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+func last(v any) (any, error) {
+	list, err := toList(v)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return list[len(list)-1], nil
+}
+
+// uniq removes duplicated elements from a list.
+// The order of elements is kept.
+func uniq(v any) ([]any, error) {
+	list, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+	arr := make([]any, 0, len(list))
+	for _, a := range list {
+		if !slices.ContainsFunc(arr, func(b any) bool {
+			return fmt.Sprint(a) == fmt.Sprint(b)
+		}) {
+			arr = append(arr, a)
+		}
+	}
+	return arr, nil
+}
+
+// sortAlpha converts elements of a list to strings and sorts them.
+func sortAlpha(v any) ([]string, error) {
+	list, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+	arr := make([]string, len(list))
+	for i, a := range list {
+		arr[i] = toString(a)
+	}
+	slices.Sort(arr)
+	return arr, nil
+}
+
+// groupBy groups a list of objects by the value of the given key.
+// e.g. {{range $name, $errors := groupBy "name" .errors}}
+// If an object doesn't have the key, the object is grouped by an empty string.
+func groupBy(key string, v any) (map[string][]any, error) {
+	list, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+	groups := map[string][]any{}
+	for _, a := range list {
+		m, ok := a.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("an element of the list must be an object but got %T", a)
+		}
+		k := toString(m[key])
+		groups[k] = append(groups[k], a)
+	}
+	return groups, nil
+}
+
+// dict creates a map from pairs of keys and values.
+// e.g. dict "name" "foo" "age" 10
+func dict(pairs ...any) (map[string]any, error) {
+	if len(pairs)%2 != 0 {
+		return nil, errors.New("the number of arguments of dict must be even")
+	}
+	m := make(map[string]any, len(pairs)/2) //nolint:mnd
+	for i := 0; i < len(pairs); i += 2 {
+		m[toString(pairs[i])] = pairs[i+1]
+	}
+	return m, nil
+}
+
+// keys returns sorted keys of a map.
+func keys(m map[string]any) []string {
+	return slices.Sorted(maps.Keys(m))
+}
+
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal a value as JSON: %w", err)
+	}
+	return string(b), nil
+}
+
+func toPrettyJSON(v any) (string, error) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal a value as JSON: %w", err)
+	}
+	return string(b), nil
+}
+
+func toYAML(v any) (string, error) {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal a value as YAML: %w", err)
+	}
+	return strings.TrimSuffix(string(b), "\n"), nil
+}
+
+var markdownEscaper = strings.NewReplacer( //nolint:gochecknoglobals
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"#", `\#`,
+	"|", `\|`,
+	"<", `\<`,
+	">", `\>`,
+	"~", `\~`,
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// markdownEscape escapes Markdown special characters which change the format of inline text.
+// Newlines are replaced with spaces so that the string can be embedded in a table cell.
+func markdownEscape(v any) string {
+	return markdownEscaper.Replace(toString(v))
+}
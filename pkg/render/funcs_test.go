@@ -0,0 +1,169 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lintnet/lintnet/pkg/render"
+)
+
+func TestFuncMap(t *testing.T) { //nolint:funlen
+	t.Parallel()
+	errs := []any{
+		map[string]any{
+			"name":      "description is required",
+			"data_file": "foo.json",
+		},
+		map[string]any{
+			"name":      "name is too long",
+			"data_file": "foo.json",
+			"message":   "name_a | name_b",
+		},
+		map[string]any{
+			"name":      "description is required",
+			"data_file": "bar.json",
+		},
+	}
+	data := []struct {
+		name     string
+		template string
+		partials map[string]string
+		data     any
+		exp      string
+	}{
+		{
+			name:     "string functions",
+			template: `{{"Hello" | upper}} {{"  hello  " | trim | repeat 2}} {{truncate 3 "hello"}} {{"foo,bar" | split "," | join "/"}}`,
+			exp:      "HELLO hellohello hel foo/bar",
+		},
+		{
+			name:     "indent",
+			template: `a:{{. | nindent 2}}`,
+			data:     "b: 1\nc: 2",
+			exp:      "a:\n  b: 1\n  c: 2",
+		},
+		{
+			name:     "groupBy",
+			template: `{{range $name, $errs := groupBy "name" .}}{{$name}}: {{len $errs}}\n{{end}}`,
+			data:     errs,
+			exp:      `description is required: 2\nname is too long: 1\n`,
+		},
+		{
+			name:     "list",
+			template: `{{$files := list}}{{range .}}{{$files = append $files .data_file}}{{end}}{{$files | uniq | sortAlpha | join ", "}}`,
+			data:     errs,
+			exp:      "bar.json, foo.json",
+		},
+		{
+			name:     "dict",
+			template: `{{$d := dict "b" 1 "a" 2}}{{keys $d | join ","}} {{get $d "a"}} {{hasKey $d "c"}} {{default "none" (get $d "c")}}`,
+			exp:      "a,b 2 false none",
+		},
+		{
+			name:     "encoding",
+			template: `{{toJson (dict "a" 1)}} {{toYaml (dict "a" 1)}} {{(index . 1).message | markdownEscape}}`,
+			data:     errs,
+			exp:      `{"a":1} a: 1 name\_a \| name\_b`,
+		},
+		{
+			name:     "partial",
+			template: `{{range .}}{{template "_row.tpl" .}}{{end}}{{include "_row.tpl" (index . 0) | indent 2}}`,
+			partials: map[string]string{
+				"_row.tpl": "- {{.data_file}}: {{.name}}\n",
+			},
+			data: errs,
+			exp:  "- foo.json: description is required\n- foo.json: name is too long\n- bar.json: description is required\n  - foo.json: description is required\n  ",
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			d.template = strings.ReplaceAll(d.template, `\n`, "\n")
+			d.exp = strings.ReplaceAll(d.exp, `\n`, "\n")
+			tpl, err := (&render.TextTemplateRenderer{}).Compile(d.template, d.partials)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := &strings.Builder{}
+			if err := tpl.Execute(b, d.data); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != d.exp {
+				t.Fatalf("got %q, wanted %q", b.String(), d.exp)
+			}
+		})
+	}
+}
+
+func TestHTMLTemplateRenderer_Compile(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		name     string
+		template string
+		partials map[string]string
+		data     any
+		exp      string
+	}{
+		{
+			name:     "template action",
+			template: `<ul>{{template "_row.tpl" .}}</ul>`,
+			partials: map[string]string{
+				"_row.tpl": `<li>{{.}}</li>`,
+			},
+			data: "<b>",
+			exp:  `<ul><li>&lt;b&gt;</li></ul>`,
+		},
+		{
+			name:     "include in an attribute",
+			template: `<a title="{{include "_title.tpl" .}}">x</a>`,
+			partials: map[string]string{
+				"_title.tpl": `{{.}}" onclick="alert(1)`,
+			},
+			data: "foo",
+			exp:  `<a title="foo&#34; onclick=&#34;alert(1)">x</a>`,
+		},
+		{
+			name:     "include in a script",
+			template: `<script>var s = {{include "_title.tpl" .}};</script>`,
+			partials: map[string]string{
+				"_title.tpl": `</script><script>alert(1)</script>`,
+			},
+			exp: `<script>var s = "\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e";</script>`,
+		},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			t.Parallel()
+			tpl, err := (&render.HTMLTemplateRenderer{}).Compile(d.template, d.partials)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := &strings.Builder{}
+			if err := tpl.Execute(b, d.data); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != d.exp {
+				t.Fatalf("got %q, wanted %q", b.String(), d.exp)
+			}
+		})
+	}
+}
+
+func TestCompile_reservedPartialName(t *testing.T) {
+	t.Parallel()
+	renderers := map[string]render.TemplateRenderer{
+		"text/template": &render.TextTemplateRenderer{},
+		"html/template": &render.HTMLTemplateRenderer{},
+	}
+	for name, renderer := range renderers {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// The partial "_" would overwrite the main template.
+			if _, err := renderer.Compile(`main`, map[string]string{
+				"_": `partial`,
+			}); err == nil {
+				t.Fatal("error must be returned")
+			}
+		})
+	}
+}
@@ -3,13 +3,34 @@ package render
 import (
 	"fmt"
 	hTemplate "html/template"
+	"strings"
 )
 
 type HTMLTemplateRenderer struct{}
 
-func (t *HTMLTemplateRenderer) Compile(s string) (Template, error) {
-	tpl, err := hTemplate.New("_").Parse(s)
-	if err != nil {
+func (t *HTMLTemplateRenderer) Compile(s string, partials map[string]string) (Template, error) {
+	if err := validatePartials(partials); err != nil {
+		return nil, err
+	}
+	tpl := hTemplate.New(rootTemplateName).Funcs(FuncMap())
+	tpl.Funcs(hTemplate.FuncMap{
+		// include executes a template and returns the result as a string, so the result can be passed to other functions.
+		// The result isn't trusted and is escaped according to the context where it's used.
+		// To embed a partial as HTML, use the template action instead.
+		"include": func(name string, data any) (string, error) {
+			b := &strings.Builder{}
+			if err := tpl.ExecuteTemplate(b, name, data); err != nil {
+				return "", err //nolint:wrapcheck
+			}
+			return b.String(), nil
+		},
+	})
+	for name, partial := range partials {
+		if _, err := tpl.New(name).Parse(partial); err != nil {
+			return nil, fmt.Errorf("parse a partial template: %w", err)
+		}
+	}
+	if _, err := tpl.Parse(s); err != nil {
 		return nil, fmt.Errorf("parse a template: %w", err)
 	}
 	return tpl, nil
@@ -1,13 +1,29 @@
 package render
 
 import (
+	"fmt"
 	"io"
 )
 
+// rootTemplateName is the name of the main template.
+// Partial templates can't use it because they would overwrite the main template.
+const rootTemplateName = "_"
+
 type TemplateRenderer interface {
-	Compile(s string) (Template, error)
+	// Compile parses a template.
+	// partials is a map of template names and templates.
+	// The main template can include partials by the template action and the function include.
+	// The name "_" is reserved for the main template.
+	Compile(s string, partials map[string]string) (Template, error)
 }
 
 type Template interface {
 	Execute(wr io.Writer, data any) error
 }
+
+func validatePartials(partials map[string]string) error {
+	if _, ok := partials[rootTemplateName]; ok {
+		return fmt.Errorf("the partial template name %q is reserved for the main template", rootTemplateName)
+	}
+	return nil
+}
@@ -2,14 +2,33 @@ package render
 
 import (
 	"fmt"
+	"strings"
 	"text/template"
 )
 
 type TextTemplateRenderer struct{}
 
-func (t *TextTemplateRenderer) Compile(s string) (Template, error) {
-	tpl, err := template.New("_").Parse(s)
-	if err != nil {
+func (t *TextTemplateRenderer) Compile(s string, partials map[string]string) (Template, error) {
+	if err := validatePartials(partials); err != nil {
+		return nil, err
+	}
+	tpl := template.New(rootTemplateName).Funcs(FuncMap())
+	tpl.Funcs(template.FuncMap{
+		// include executes a template and returns the result as a string, so the result can be passed to other functions such as indent.
+		"include": func(name string, data any) (string, error) {
+			b := &strings.Builder{}
+			if err := tpl.ExecuteTemplate(b, name, data); err != nil {
+				return "", err //nolint:wrapcheck
+			}
+			return b.String(), nil
+		},
+	})
+	for name, partial := range partials {
+		if _, err := tpl.New(name).Parse(partial); err != nil {
+			return nil, fmt.Errorf("parse a partial template: %w", err)
+		}
+	}
+	if _, err := tpl.Parse(s); err != nil {
 		return nil, fmt.Errorf("parse a template: %w", err)
 	}
 	return tpl, nil